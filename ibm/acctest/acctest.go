@@ -251,6 +251,7 @@ var CdEventNotificationsInstanceName string
 // VPN Server
 var ISCertificateCrn string
 var ISClientCaCrn string
+var ISClientCertificateCrn string
 
 // COS Replication Bucket
 var IBM_AccountID_REPL string
@@ -1389,6 +1390,11 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable IS_CLIENT_CA_CRN for testing ibm_is_vpn_server resource")
 	}
 
+	ISClientCertificateCrn = os.Getenv("IS_CLIENT_CERTIFICATE_CRN")
+	if ISClientCertificateCrn == "" {
+		fmt.Println("[INFO] Set the environment variable IS_CLIENT_CERTIFICATE_CRN for testing ibm_is_vpn_server_client_profile data source")
+	}
+
 	IBM_AccountID_REPL = os.Getenv("IBM_AccountID_REPL")
 	if IBM_AccountID_REPL == "" {
 		fmt.Println("[INFO] Set the environment variable IBM_AccountID_REPL for setting up authorization policy to enable replication feature resource or datasource else tests will fail if this is not set correctly")
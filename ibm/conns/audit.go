@@ -0,0 +1,303 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	gohttp "net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// AuditEntry is the line written to the audit log for every IBM Cloud API call.
+type AuditEntry struct {
+	Time      string `json:"time"`
+	Service   string `json:"service"`
+	Method    string `json:"method"`
+	URL       string `json:"url"`
+	Status    int    `json:"status,omitempty"`
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+	RequestID string `json:"request_id,omitempty"`
+	Retries   int    `json:"retries"`
+	// Terraform does not send the resource address to providers, the resource
+	// type and ID of the operation making the call are recorded instead
+	Resource   string `json:"resource,omitempty"`
+	ResourceID string `json:"resource_id,omitempty"`
+}
+
+// AuditLogger writes one JSON line per API call. Request and response headers
+// and bodies are never written, and secrets are redacted from URLs and errors.
+type AuditLogger struct {
+	lock sync.Mutex
+	out  io.Writer
+}
+
+// NewAuditLogger returns an AuditLogger appending to the file at path.
+func NewAuditLogger(path string) (*AuditLogger, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to open audit log %s: %s", path, err)
+	}
+	return &AuditLogger{out: file}, nil
+}
+
+// Log writes the entry as a single JSON line.
+func (l *AuditLogger) Log(entry AuditEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.out.Write(append(line, '\n'))
+}
+
+// Close closes the underlying file.
+func (l *AuditLogger) Close() error {
+	if closer, ok := l.out.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+type auditResourceKey struct{}
+
+type auditResource struct {
+	resourceType string
+	id           string
+	secrets      []string
+}
+
+// WithAuditResource records the Terraform resource making the API calls sent
+// with ctx. The secrets, typically the values of the Sensitive attributes of
+// the resource, are redacted wherever they appear in the audit log.
+func WithAuditResource(ctx context.Context, resourceType, id string, secrets []string) context.Context {
+	return context.WithValue(ctx, auditResourceKey{}, &auditResource{
+		resourceType: resourceType,
+		id:           id,
+		secrets:      secrets,
+	})
+}
+
+var (
+	auditSecretsLock sync.Mutex
+	auditSecrets     = map[string]int{}
+)
+
+// RegisterAuditSecrets redacts the secrets from every entry of the audit log
+// until release is called. It is used by the operations that cannot pass a
+// context to the API calls they make, so their calls are not attributed to a
+// resource, but the values of its Sensitive attributes are still redacted.
+func RegisterAuditSecrets(secrets []string) (release func()) {
+	auditSecretsLock.Lock()
+	defer auditSecretsLock.Unlock()
+	for _, secret := range secrets {
+		auditSecrets[secret]++
+	}
+	return func() {
+		auditSecretsLock.Lock()
+		defer auditSecretsLock.Unlock()
+		for _, secret := range secrets {
+			if auditSecrets[secret]--; auditSecrets[secret] <= 0 {
+				delete(auditSecrets, secret)
+			}
+		}
+	}
+}
+
+func registeredAuditSecrets() []string {
+	auditSecretsLock.Lock()
+	defer auditSecretsLock.Unlock()
+	secrets := make([]string, 0, len(auditSecrets))
+	for secret := range auditSecrets {
+		secrets = append(secrets, secret)
+	}
+	return secrets
+}
+
+type auditCallKey struct{}
+
+// auditCall counts the attempts of one API call sent through a retrying client,
+// logger is set by the audit transport of the session the attempts go through.
+type auditCall struct {
+	attempts int32
+	logger   atomic.Value
+}
+
+// auditCallTransport wraps a retrying round tripper so that the audit log has one
+// entry per API call, with the number of retries the call needed.
+type auditCallTransport struct {
+	next gohttp.RoundTripper
+}
+
+func (t *auditCallTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	call := &auditCall{}
+	req = req.WithContext(context.WithValue(req.Context(), auditCallKey{}, call))
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	logger, ok := call.logger.Load().(*AuditLogger)
+	if !ok {
+		return resp, err
+	}
+	retries := int(atomic.LoadInt32(&call.attempts)) - 1
+	if retries < 0 {
+		retries = 0
+	}
+	logger.Log(newAuditEntry(req, resp, err, time.Since(start), retries))
+	return resp, err
+}
+
+// auditTransport is the audit hook of the transport of a session, it writes to
+// the audit log of the session, if any. Attempts of a call made through an
+// auditCallTransport are counted, the others are logged directly.
+type auditTransport struct {
+	next   gohttp.RoundTripper
+	logger *AuditLogger
+}
+
+// NewAuditTransport returns a transport writing every API call sent through
+// next to logger. Nothing is logged when logger is nil.
+func NewAuditTransport(next gohttp.RoundTripper, logger *AuditLogger) gohttp.RoundTripper {
+	return &auditTransport{next: next, logger: logger}
+}
+
+func (t *auditTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if t.logger == nil {
+		return t.next.RoundTrip(req)
+	}
+	if call, ok := req.Context().Value(auditCallKey{}).(*auditCall); ok {
+		atomic.AddInt32(&call.attempts, 1)
+		call.logger.Store(t.logger)
+		return t.next.RoundTrip(req)
+	}
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	t.logger.Log(newAuditEntry(req, resp, err, time.Since(start), 0))
+	return resp, err
+}
+
+func newAuditEntry(req *gohttp.Request, resp *gohttp.Response, err error, latency time.Duration, retries int) AuditEntry {
+	secrets := registeredAuditSecrets()
+	entry := AuditEntry{
+		Time:      time.Now().UTC().Format(time.RFC3339Nano),
+		Service:   auditServiceName(req.URL.Hostname()),
+		Method:    req.Method,
+		LatencyMs: latency.Milliseconds(),
+		Retries:   retries,
+	}
+	if resource, ok := req.Context().Value(auditResourceKey{}).(*auditResource); ok {
+		entry.Resource = resource.resourceType
+		entry.ResourceID = resource.id
+		secrets = append(secrets, resource.secrets...)
+	}
+	entry.URL = auditURLTemplate(req.URL, secrets)
+	if resp != nil {
+		entry.Status = resp.StatusCode
+		entry.RequestID = auditRequestID(resp.Header)
+	}
+	if entry.RequestID == "" {
+		entry.RequestID = auditRequestID(req.Header)
+	}
+	if err != nil {
+		// The URL of a url.Error can hold credentials, it is already in the entry
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
+		entry.Error = Redact(err.Error(), secrets)
+	}
+	return entry
+}
+
+// auditServiceName derives the service from the API host, for example iaas
+// for us-south.iaas.cloud.ibm.com.
+func auditServiceName(host string) string {
+	for _, domain := range []string{".cloud.ibm.com", ".appdomain.cloud", ".bluemix.net"} {
+		if strings.HasSuffix(host, domain) {
+			labels := strings.Split(strings.TrimSuffix(host, domain), ".")
+			return labels[len(labels)-1]
+		}
+	}
+	if strings.HasSuffix(host, "softlayer.com") {
+		return "softlayer"
+	}
+	return host
+}
+
+var auditRequestIDHeaders = []string{"X-Request-Id", "X-Global-Transaction-Id", "X-Correlation-Id", "Transaction-Id"}
+
+func auditRequestID(header gohttp.Header) string {
+	for _, name := range auditRequestIDHeaders {
+		if id := header.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+var auditIDSegment = regexp.MustCompile(`^(crn:.*|[0-9]+|.*[0-9].*[0-9a-fA-F_-]{7,}.*)$`)
+
+// auditURLTemplate returns the URL without credentials or query values, with
+// the path segments that look like IDs replaced by {id}.
+func auditURLTemplate(u *url.URL, secrets []string) string {
+	segments := strings.Split(u.EscapedPath(), "/")
+	for i, segment := range segments {
+		if segment == "" {
+			continue
+		}
+		if unescaped, err := url.PathUnescape(segment); err == nil && containsSecret(unescaped, secrets) {
+			segments[i] = "{redacted}"
+		} else if auditIDSegment.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	template := u.Scheme + "://" + u.Host + strings.Join(segments, "/")
+	if query := u.Query(); len(query) > 0 {
+		keys := make([]string, 0, len(query))
+		for key := range query {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		template += "?" + strings.Join(keys, "&")
+	}
+	return template
+}
+
+func containsSecret(s string, secrets []string) bool {
+	for _, secret := range secrets {
+		if secret != "" && strings.Contains(s, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+var redactPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)(authorization["']?\s*[:=]\s*["']?)(?:(?:bearer|basic)\s+)?[^\s&"',}]+`),
+	regexp.MustCompile(`(?i)((?:bearer|basic)\s+)[A-Za-z0-9\-._~+/]+=*`),
+	regexp.MustCompile(`(?i)((?:api_?key|password|passwd|secret|token)["']?\s*[:=]\s*["']?)[^\s&"',}]+`),
+}
+
+// Redact replaces the secrets, authorization credentials, API keys, passwords
+// and tokens found in s.
+func Redact(s string, secrets []string) string {
+	for _, secret := range secrets {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, "REDACTED")
+		}
+	}
+	for _, pattern := range redactPatterns {
+		s = pattern.ReplaceAllString(s, "${1}REDACTED")
+	}
+	return s
+}
@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	gohttp "net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/IBM-Cloud/bluemix-go/models"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// Types of cloud data looked up by CloudDataValues.
+const (
+	// The VPC instance profiles of the region of the provider
+	CloudDataInstanceProfile = "is_instance_profile"
+	// The worker node flavors of a zone, or of the region of the provider for VPC clusters
+	CloudDataKubernetesFlavor = "container_flavor"
+	// The versions of a Cloud Databases service, like databases-for-postgresql
+	CloudDataDatabaseVersion = "database_version"
+	// The plans of a service of the Resource Controller catalog
+	CloudDataServicePlan = "resource_plan"
+)
+
+// CloudDataValues returns the values of the type of cloud data in the scope,
+// which is empty for the types that don't need one. The values are looked up
+// on first use and kept for the lifetime of the session. ok is false when the
+// type of cloud data can't be looked up.
+func (session *clientSession) CloudDataValues(dataType, scope string) (values []string, ok bool, err error) {
+	var lookup func() ([]string, error)
+	switch dataType {
+	case CloudDataInstanceProfile:
+		lookup = func() ([]string, error) { return InstanceProfileNames(session) }
+	case CloudDataKubernetesFlavor:
+		lookup = func() ([]string, error) { return KubernetesFlavorNames(session, scope) }
+	case CloudDataDatabaseVersion:
+		lookup = func() ([]string, error) { return DatabaseVersions(session, scope) }
+	case CloudDataServicePlan:
+		lookup = func() ([]string, error) { return ServicePlanNames(session, scope) }
+	default:
+		return nil, false, nil
+	}
+	values, err = session.cloudData.values(dataType, scope, lookup)
+	return values, true, err
+}
+
+// cloudDataCatalog keeps the cloud data looked up by a session.
+type cloudDataCatalog struct {
+	mu      sync.Mutex
+	entries map[cloudDataKey]*cloudDataEntry
+}
+
+type cloudDataKey struct {
+	dataType string
+	scope    string
+}
+
+type cloudDataEntry struct {
+	once   sync.Once
+	values []string
+	err    error
+}
+
+// values looks the type of cloud data up in the scope on first use only.
+func (c *cloudDataCatalog) values(dataType, scope string, lookup func() ([]string, error)) ([]string, error) {
+	key := cloudDataKey{dataType: dataType, scope: scope}
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = map[cloudDataKey]*cloudDataEntry{}
+	}
+	entry, found := c.entries[key]
+	if !found {
+		entry = &cloudDataEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.values, entry.err = lookup()
+	})
+	return entry.values, entry.err
+}
+
+// VPC zones are named after their region, like us-south-1, classic ones after
+// their datacenter, like dal10.
+var vpcZoneRegexp = regexp.MustCompile(`^[a-z]+-[a-z]+-[0-9]+$`)
+
+// InstanceProfileNames lists the VPC instance profiles of the region of the session.
+func InstanceProfileNames(sess ClientSession) ([]string, error) {
+	vpcClient, err := sess.VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	profiles, response, err := vpcClient.ListInstanceProfiles(&vpc.ListInstanceProfilesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing instance profiles: %s\n%s", err, response)
+	}
+	names := make([]string, 0, len(profiles.Profiles))
+	for _, profile := range profiles.Profiles {
+		if profile.Name != nil {
+			names = append(names, *profile.Name)
+		}
+	}
+	return names, nil
+}
+
+// KubernetesFlavorNames lists the worker node flavors of the zone, or of the
+// VPC zones of the region of the session when the zone is empty.
+func KubernetesFlavorNames(sess ClientSession, zone string) ([]string, error) {
+	csClient, err := sess.VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	// The flavors are not part of the containerv2 API, use its client directly
+	client, ok := csClient.(interface {
+		Get(path string, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] The container service client can't list flavors")
+	}
+
+	provider := "classic"
+	zones := []string{zone}
+	if zone == "" || vpcZoneRegexp.MatchString(zone) {
+		provider = "vpc-gen2"
+	}
+	if zone == "" {
+		if zones, err = regionZoneNames(sess); err != nil {
+			return nil, err
+		}
+	}
+
+	var names []string
+	seen := map[string]bool{}
+	for _, zone := range zones {
+		var flavors []struct {
+			Name string `json:"name"`
+		}
+		if _, err := client.Get(fmt.Sprintf("/v2/getFlavors?zone=%s&provider=%s", zone, provider), &flavors); err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing the flavors of zone %s: %s", zone, err)
+		}
+		for _, flavor := range flavors {
+			if !seen[flavor.Name] {
+				seen[flavor.Name] = true
+				names = append(names, flavor.Name)
+			}
+		}
+	}
+	return names, nil
+}
+
+// regionZoneNames lists the VPC zones of the region of the session.
+func regionZoneNames(sess ClientSession) ([]string, error) {
+	bxSession, err := sess.BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	vpcClient, err := sess.VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	region := bxSession.Config.Region
+	zones, response, err := vpcClient.ListRegionZones(&vpc.ListRegionZonesOptions{RegionName: &region})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing the zones of region %s: %s\n%s", region, err, response)
+	}
+	names := make([]string, 0, len(zones.Zones))
+	for _, zone := range zones.Zones {
+		if zone.Name != nil {
+			names = append(names, *zone.Name)
+		}
+	}
+	return names, nil
+}
+
+// DatabaseVersions lists the versions of a Cloud Databases service, like
+// databases-for-postgresql.
+func DatabaseVersions(sess ClientSession, service string) ([]string, error) {
+	icdClient, err := sess.CloudDatabasesV5()
+	if err != nil {
+		return nil, err
+	}
+	deployables, response, err := icdClient.ListDeployables(&clouddatabasesv5.ListDeployablesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing database deployables: %s\n%s", err, response)
+	}
+
+	var dbType string
+	if service == "databases-for-cassandra" {
+		dbType = "datastax_enterprise_full"
+	} else if strings.HasPrefix(service, "messages-for-") {
+		dbType = strings.TrimPrefix(service, "messages-for-")
+	} else {
+		dbType = strings.TrimPrefix(service, "databases-for-")
+	}
+
+	var versions []string
+	for _, deployable := range deployables.Deployables {
+		if deployable.Type == nil || *deployable.Type != dbType {
+			continue
+		}
+		for _, version := range deployable.Versions {
+			if version.Version != nil {
+				versions = append(versions, *version.Version)
+			}
+		}
+	}
+	return versions, nil
+}
+
+// ServicePlanNames lists the plans of a service of the Resource Controller catalog.
+func ServicePlanNames(sess ClientSession, service string) ([]string, error) {
+	rsCatClient, err := sess.ResourceCatalogAPI()
+	if err != nil {
+		return nil, err
+	}
+	rsCatRepo := rsCatClient.ResourceCatalog()
+	services, err := rsCatRepo.FindByName(service, true)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	err = rsCatRepo.ListServicePlans(func(plan models.ServicePlan) bool {
+		names = append(names, plan.Name)
+		return true
+	}, services[0])
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing the plans of %s: %s", service, err)
+	}
+	return names, nil
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev1/catalog"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// catalogSession serves the clients of the catalog lookups from a local stub.
+type catalogSession struct {
+	ClientSession
+	bxSession *bxsession.Session
+	vpcClient *vpc.VpcV1
+	icdClient *clouddatabasesv5.CloudDatabasesV5
+}
+
+func (s *catalogSession) BluemixSession() (*bxsession.Session, error) {
+	return s.bxSession, nil
+}
+
+func (s *catalogSession) VpcV1API() (*vpc.VpcV1, error) {
+	return s.vpcClient, nil
+}
+
+func (s *catalogSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	return containerv2.New(s.bxSession)
+}
+
+func (s *catalogSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+	return s.icdClient, nil
+}
+
+func (s *catalogSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	return catalog.New(s.bxSession)
+}
+
+func newCatalogSession(t *testing.T, handler http.Handler) *catalogSession {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	endpoint := server.URL
+	bxSession, err := bxsession.New(&bluemix.Config{
+		Region:          "us-south",
+		IAMAccessToken:  "Bearer token",
+		IAMRefreshToken: "refresh-token",
+		Endpoint:        &endpoint,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	vpcClient, err := vpc.NewVpcV1(&vpc.VpcV1Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	icdClient, err := clouddatabasesv5.NewCloudDatabasesV5(&clouddatabasesv5.CloudDatabasesV5Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &catalogSession{bxSession: bxSession, vpcClient: vpcClient, icdClient: icdClient}
+}
+
+func TestCatalogLookups(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/instance/profiles", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"profiles":[{"name":"bx2-2x8"},{"name":"cx2-2x4"}]}`))
+	})
+	// us-south-2 is left out, the zones of the region are looked up rather than assumed
+	mux.HandleFunc("/regions/us-south/zones", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"zones":[{"name":"us-south-1"},{"name":"us-south-3"}]}`))
+	})
+	mux.HandleFunc("/v2/getFlavors", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("zone") + "/" + r.URL.Query().Get("provider") {
+		case "dal10/classic":
+			w.Write([]byte(`[{"name":"b3c.4x16"}]`))
+		case "us-south-1/vpc-gen2":
+			w.Write([]byte(`[{"name":"bx2.4x16"},{"name":"cx2.2x4"}]`))
+		case "us-south-3/vpc-gen2":
+			w.Write([]byte(`[{"name":"bx2.4x16"},{"name":"mx2.4x32"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	mux.HandleFunc("/deployables", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"deployables":[
+			{"type":"postgresql","versions":[{"version":"13"},{"version":"14"}]},
+			{"type":"rabbitmq","versions":[{"version":"3.11"}]}]}`))
+	})
+	mux.HandleFunc("/api/v1/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/":
+			w.Write([]byte(`{"resources":[{"id":"cos-id","name":"cloud-object-storage","kind":"service"}]}`))
+		case "/api/v1/cos-id/plan":
+			w.Write([]byte(`{"resources":[{"id":"lite-id","name":"lite"},{"id":"standard-id","name":"standard"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	sess := newCatalogSession(t, mux)
+
+	cases := []struct {
+		name     string
+		lookup   func() ([]string, error)
+		expected []string
+	}{
+		{"instance profiles", func() ([]string, error) { return InstanceProfileNames(sess) }, []string{"bx2-2x8", "cx2-2x4"}},
+		{"classic flavors", func() ([]string, error) { return KubernetesFlavorNames(sess, "dal10") }, []string{"b3c.4x16"}},
+		{"vpc zone flavors", func() ([]string, error) { return KubernetesFlavorNames(sess, "us-south-1") }, []string{"bx2.4x16", "cx2.2x4"}},
+		{"vpc region flavors", func() ([]string, error) { return KubernetesFlavorNames(sess, "") }, []string{"bx2.4x16", "cx2.2x4", "mx2.4x32"}},
+		{"database versions", func() ([]string, error) { return DatabaseVersions(sess, "databases-for-postgresql") }, []string{"13", "14"}},
+		{"message queue versions", func() ([]string, error) { return DatabaseVersions(sess, "messages-for-rabbitmq") }, []string{"3.11"}},
+		{"service plans", func() ([]string, error) { return ServicePlanNames(sess, "cloud-object-storage") }, []string{"lite", "standard"}},
+	}
+	for _, c := range cases {
+		values, err := c.lookup()
+		if err != nil {
+			t.Errorf("%s: %s", c.name, err)
+			continue
+		}
+		sort.Strings(values)
+		if !reflect.DeepEqual(values, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, values)
+		}
+	}
+}
+
+func TestCatalogLookupError(t *testing.T) {
+	sess := newCatalogSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	if _, err := InstanceProfileNames(sess); err == nil {
+		t.Fatal("expected the lookup to fail")
+	}
+}
+
+func TestCloudDataCatalog(t *testing.T) {
+	var c cloudDataCatalog
+	lookups := map[string]int{}
+	lookup := func(scope string) func() ([]string, error) {
+		return func() ([]string, error) {
+			lookups[scope]++
+			return []string{scope + "-plan"}, nil
+		}
+	}
+	for i := 0; i < 3; i++ {
+		for _, scope := range []string{"cloud-object-storage", "kms"} {
+			values, err := c.values(CloudDataServicePlan, scope, lookup(scope))
+			if err != nil || !reflect.DeepEqual(values, []string{scope + "-plan"}) {
+				t.Fatalf("unexpected values of %s: %v, %v", scope, values, err)
+			}
+		}
+	}
+	if !reflect.DeepEqual(lookups, map[string]int{"cloud-object-storage": 1, "kms": 1}) {
+		t.Fatalf("expected one lookup per scope, got %v", lookups)
+	}
+
+	if _, ok, _ := (&clientSession{}).CloudDataValues("region", ""); ok {
+		t.Fatal("expected no lookup for an unknown type of cloud data")
+	}
+}
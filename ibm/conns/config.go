@@ -4,16 +4,14 @@
 package conns
 
 import (
-	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"net"
 	gohttp "net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	// Added code for the Power Colo Offering
@@ -28,6 +26,7 @@ import (
 	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
 	"github.com/IBM/go-sdk-core/v5/core"
 	cosconfig "github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	kp "github.com/IBM/keyprotect-go-client"
 	cisalertsv1 "github.com/IBM/networking-go-sdk/alertsv1"
 	cisoriginpull "github.com/IBM/networking-go-sdk/authenticatedoriginpullapiv1"
@@ -169,6 +168,17 @@ type Config struct {
 	RetryCount int
 	// Constant Retry Delay for API calls
 	RetryDelay time.Duration
+	// RetryPolicy applied to every SDK client, derived from RetryCount and RetryDelay when nil
+	RetryPolicy *RetryPolicy
+
+	// Transport configures the HTTP connection pool shared by every SDK client,
+	// DefaultTransportConfig is used when nil
+	Transport *TransportConfig
+	// AuditLogPath is the file every API call is logged to, no audit log when empty
+	AuditLogPath string
+	// LockTimeout is the longest time to wait for the lock shared with other
+	// resources, no limit other than the timeout of the operation when zero
+	LockTimeout time.Duration
 
 	// FunctionNameSpace ...
 	FunctionNameSpace string
@@ -192,6 +202,12 @@ type Config struct {
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+
+	// PrivateEndpointsOnly fails the provider configuration instead of falling back to public endpoints
+	PrivateEndpointsOnly bool
+
+	// DefaultTags are the provider level tags attached to every taggable resource
+	DefaultTags []string
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -201,6 +217,12 @@ type Session struct {
 
 	// BluemixSession is the the Bluemix session used to connect to the Bluemix API
 	BluemixSession *bxsession.Session
+
+	// transport is the HTTP transport of every client built from the session
+	transport gohttp.RoundTripper
+
+	// auditLogger writes the API calls sent through transport, nil when the audit log is disabled
+	auditLogger *AuditLogger
 }
 
 // ClientSession ...
@@ -297,36 +319,61 @@ type ClientSession interface {
 	CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error)
 	CodeEngineV2() (*codeengine.CodeEngineV2, error)
 	ProjectV1() (*project.ProjectV1, error)
+	DefaultTags() []string
+	AuditEnabled() bool
+	LockTimeout() time.Duration
+	CloudDataValues(dataType, scope string) (values []string, ok bool, err error)
+	CosS3API(region, instanceCRN string) (*s3.S3, error)
 }
 
 type clientSession struct {
 	session *Session
 
-	appidErr error
-	appidAPI *appid.AppIDManagementV4
+	// Shared by the service clients, which are built on first use
+	config        *Config
+	fileMap       EndpointsFile
+	authenticator core.Authenticator
+	iamURL        string
+	retryPolicy   *RetryPolicy
+
+	// The cloud data checked at plan time, looked up once per session
+	cloudData cloudDataCatalog
 
-	apigatewayErr error
-	apigatewayAPI *apigateway.ApiGatewayControllerApiV1
+	defaultTags []string
+
+	appidErr  error
+	appidOnce sync.Once
+	appidAPI  *appid.AppIDManagementV4
+
+	apigatewayErr  error
+	apigatewayOnce sync.Once
+	apigatewayAPI  *apigateway.ApiGatewayControllerApiV1
 
 	accountConfigErr     error
+	accountOnce          sync.Once
 	bmxAccountServiceAPI accountv2.AccountServiceAPI
 
 	accountV1ConfigErr     error
+	accountV1Once          sync.Once
 	bmxAccountv1ServiceAPI accountv1.AccountServiceAPI
 
 	bmxUserDetails  *UserConfig
 	bmxUserFetchErr error
 
 	csConfigErr  error
+	csOnce       sync.Once
 	csServiceAPI containerv1.ContainerServiceAPI
 
 	csv2ConfigErr  error
+	csv2Once       sync.Once
 	csv2ServiceAPI containerv2.ContainerServiceAPI
 
-	containerRegistryClientErr error
-	containerRegistryClient    *containerregistryv1.ContainerRegistryV1
+	containerRegistryClientErr  error
+	containerRegistryClientOnce sync.Once
+	containerRegistryClient     *containerregistryv1.ContainerRegistryV1
 
 	cfConfigErr  error
+	cfOnce       sync.Once
 	cfServiceAPI mccpv2.MccpServiceAPI
 
 	cisConfigErr  error
@@ -336,336 +383,442 @@ type clientSession struct {
 	functionClient    *whisk.Client
 
 	globalSearchConfigErr  error
+	globalSearchConfigOnce sync.Once
 	globalSearchServiceAPI globalsearchv2.GlobalSearchServiceAPI
 
 	globalTaggingConfigErr  error
+	globalTaggingConfigOnce sync.Once
 	globalTaggingServiceAPI globaltaggingv3.GlobalTaggingServiceAPI
 
 	globalTaggingConfigErrV1  error
+	globalTaggingConfigOnceV1 sync.Once
 	globalTaggingServiceAPIV1 globaltaggingv1.GlobalTaggingV1
 
 	globalSearchConfigErrV2  error
+	globalSearchConfigOnceV2 sync.Once
 	globalSearchServiceAPIV2 searchv2.GlobalSearchV2
 
-	ibmCloudShellClient    *ibmcloudshellv1.IBMCloudShellV1
-	ibmCloudShellClientErr error
+	ibmCloudShellClient     *ibmcloudshellv1.IBMCloudShellV1
+	ibmCloudShellClientErr  error
+	ibmCloudShellClientOnce sync.Once
 
-	userManagementErr error
-	userManagementAPI usermanagementv2.UserManagementAPI
+	userManagementErr  error
+	userManagementOnce sync.Once
+	userManagementAPI  usermanagementv2.UserManagementAPI
 
 	icdConfigErr  error
+	icdConfigOnce sync.Once
 	icdServiceAPI icdv4.ICDServiceAPI
 
-	cloudDatabasesClientErr error
-	cloudDatabasesClient    *clouddatabasesv5.CloudDatabasesV5
+	cloudDatabasesClientErr  error
+	cloudDatabasesClientOnce sync.Once
+	cloudDatabasesClient     *clouddatabasesv5.CloudDatabasesV5
 
 	resourceControllerConfigErr  error
+	resourceControllerConfigOnce sync.Once
 	resourceControllerServiceAPI controller.ResourceControllerAPI
 
 	resourceControllerConfigErrv2  error
+	resourceControllerConfigOncev2 sync.Once
 	resourceControllerServiceAPIv2 controllerv2.ResourceControllerAPIV2
 
 	resourceManagementConfigErrv2  error
+	resourceManagementConfigOncev2 sync.Once
 	resourceManagementServiceAPIv2 managementv2.ResourceManagementAPIv2
 
 	resourceCatalogConfigErr  error
+	resourceCatalogConfigOnce sync.Once
 	resourceCatalogServiceAPI catalog.ResourceCatalogAPI
 
-	ibmpiConfigErr error
-	ibmpiSession   *ibmpisession.IBMPISession
+	ibmpiConfigErr  error
+	ibmpiConfigOnce sync.Once
+	ibmpiSession    *ibmpisession.IBMPISession
 
-	kpErr error
-	kpAPI *kp.API
+	kpErr  error
+	kpOnce sync.Once
+	kpAPI  *kp.API
 
-	kmsErr error
-	kmsAPI *kp.API
+	kmsErr  error
+	kmsOnce sync.Once
+	kmsAPI  *kp.API
 
-	hpcsEndpointErr error
-	hpcsEndpointAPI hpcs.HPCSV2
+	hpcsEndpointErr  error
+	hpcsEndpointOnce sync.Once
+	hpcsEndpointAPI  hpcs.HPCSV2
 
-	ukoClient    *ukov4.UkoV4
-	ukoClientErr error
+	ukoClient     *ukov4.UkoV4
+	ukoClientErr  error
+	ukoClientOnce sync.Once
 
 	pDNSClient *dns.DnsSvcsV1
 	pDNSErr    error
+	pDNSOnce   sync.Once
 
 	bluemixSessionErr error
 
-	pushServiceClient    *pushservicev1.PushServiceV1
-	pushServiceClientErr error
+	pushServiceClient     *pushservicev1.PushServiceV1
+	pushServiceClientErr  error
+	pushServiceClientOnce sync.Once
 
-	eventNotificationsApiClient    *eventnotificationsv1.EventNotificationsV1
-	eventNotificationsApiClientErr error
+	eventNotificationsApiClient     *eventnotificationsv1.EventNotificationsV1
+	eventNotificationsApiClientErr  error
+	eventNotificationsApiClientOnce sync.Once
 
-	appConfigurationClient    *appconfigurationv1.AppConfigurationV1
-	appConfigurationClientErr error
+	appConfigurationClient     *appconfigurationv1.AppConfigurationV1
+	appConfigurationClientErr  error
+	appConfigurationClientOnce sync.Once
 
-	vpcErr     error
-	vpcAPI     *vpc.VpcV1
-	vpcbetaErr error
-	vpcBetaAPI *vpcbeta.VpcbetaV1
+	vpcErr      error
+	vpcOnce     sync.Once
+	vpcAPI      *vpc.VpcV1
+	vpcbetaErr  error
+	vpcbetaOnce sync.Once
+	vpcBetaAPI  *vpcbeta.VpcbetaV1
 
-	directlinkAPI *dl.DirectLinkV1
-	directlinkErr error
-	dlProviderAPI *dlProviderV2.DirectLinkProviderV2
-	dlProviderErr error
+	directlinkAPI  *dl.DirectLinkV1
+	directlinkErr  error
+	directlinkOnce sync.Once
+	dlProviderAPI  *dlProviderV2.DirectLinkProviderV2
+	dlProviderErr  error
+	dlProviderOnce sync.Once
 
-	cosConfigErr error
-	cosConfigAPI *cosconfig.ResourceConfigurationV1
+	cosConfigErr  error
+	cosConfigOnce sync.Once
+	cosConfigAPI  *cosconfig.ResourceConfigurationV1
 
-	transitgatewayAPI *tg.TransitGatewayApisV1
-	transitgatewayErr error
+	transitgatewayAPI  *tg.TransitGatewayApisV1
+	transitgatewayErr  error
+	transitgatewayOnce sync.Once
 
-	functionIAMNamespaceAPI functions.FunctionServiceAPI
-	functionIAMNamespaceErr error
+	functionIAMNamespaceAPI  functions.FunctionServiceAPI
+	functionIAMNamespaceErr  error
+	functionIAMNamespaceOnce sync.Once
 
 	// CIS Zones
 	cisZonesErr      error
+	cisZonesOnce     sync.Once
 	cisZonesV1Client *ciszonesv1.ZonesV1
 
 	// CIS Alerts
 	cisAlertsClient *cisalertsv1.AlertsV1
 	cisAlertsErr    error
+	cisAlertsOnce   sync.Once
 
 	// CIS Authenticated Origin Pull
-	cisOriginAuthClient  *cisoriginpull.AuthenticatedOriginPullApiV1
-	cisOriginAuthPullErr error
+	cisOriginAuthClient   *cisoriginpull.AuthenticatedOriginPullApiV1
+	cisOriginAuthPullErr  error
+	cisOriginAuthPullOnce sync.Once
 
 	// CIS dns service options
 	cisDNSErr           error
+	cisDNSOnce          sync.Once
 	cisDNSRecordsClient *cisdnsrecordsv1.DnsRecordsV1
 
 	// CIS dns bulk service options
 	cisDNSBulkErr          error
+	cisDNSBulkOnce         sync.Once
 	cisDNSRecordBulkClient *cisdnsbulkv1.DnsRecordBulkV1
 
 	// CIS Global Load Balancer Pool service options
 	cisGLBPoolErr    error
+	cisGLBPoolOnce   sync.Once
 	cisGLBPoolClient *cisglbpoolv0.GlobalLoadBalancerPoolsV0
 
 	// CIS GLB service options
 	cisGLBErr    error
+	cisGLBOnce   sync.Once
 	cisGLBClient *cisglbv1.GlobalLoadBalancerV1
 
 	// CIS GLB health check service options
 	cisGLBHealthCheckErr    error
+	cisGLBHealthCheckOnce   sync.Once
 	cisGLBHealthCheckClient *cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1
 
 	// CIS IP service options
 	cisIPErr    error
+	cisIPOnce   sync.Once
 	cisIPClient *cisipv1.CisIpApiV1
 
 	// CIS Zone Rate Limits service options
 	cisRLErr    error
+	cisRLOnce   sync.Once
 	cisRLClient *cisratelimitv1.ZoneRateLimitsV1
 
 	// CIS Page Rules service options
 	cisPageRuleErr    error
+	cisPageRuleOnce   sync.Once
 	cisPageRuleClient *cispagerulev1.PageRuleApiV1
 
 	// CIS Edge Functions service options
 	cisEdgeFunctionErr    error
+	cisEdgeFunctionOnce   sync.Once
 	cisEdgeFunctionClient *cisedgefunctionv1.EdgeFunctionsApiV1
 
 	// CIS SSL certificate service options
 	cisSSLErr    error
+	cisSSLOnce   sync.Once
 	cisSSLClient *cissslv1.SslCertificateApiV1
 
 	// CIS WAF Package service options
 	cisWAFPackageErr    error
+	cisWAFPackageOnce   sync.Once
 	cisWAFPackageClient *ciswafpackagev1.WafRulePackagesApiV1
 
 	// CIS Zone Setting service options
 	cisDomainSettingsErr    error
+	cisDomainSettingsOnce   sync.Once
 	cisDomainSettingsClient *cisdomainsettingsv1.ZonesSettingsV1
 
 	// CIS Routing service options
 	cisRoutingErr    error
+	cisRoutingOnce   sync.Once
 	cisRoutingClient *cisroutingv1.RoutingV1
 
 	// CIS WAF Group service options
 	cisWAFGroupErr    error
+	cisWAFGroupOnce   sync.Once
 	cisWAFGroupClient *ciswafgroupv1.WafRuleGroupsApiV1
 
 	// CIS Caching service options
 	cisCacheErr    error
+	cisCacheOnce   sync.Once
 	cisCacheClient *ciscachev1.CachingApiV1
 
 	// CIS Custom Pages service options
 	cisCustomPageErr    error
+	cisCustomPageOnce   sync.Once
 	cisCustomPageClient *ciscustompagev1.CustomPagesV1
 
 	// CIS Firewall Access rule service option
 	cisAccessRuleErr    error
+	cisAccessRuleOnce   sync.Once
 	cisAccessRuleClient *cisaccessrulev1.ZoneFirewallAccessRulesV1
 
 	// CIS User Agent Blocking Rule service option
 	cisUARuleErr    error
+	cisUARuleOnce   sync.Once
 	cisUARuleClient *cisuarulev1.UserAgentBlockingRulesV1
 
 	// CIS Firewall Lockdwon Rule service option
 	cisLockdownErr    error
+	cisLockdownOnce   sync.Once
 	cisLockdownClient *cislockdownv1.ZoneLockdownV1
 
 	// CIS LogpushJobs service option
 	cisLogpushJobsClient *cislogpushjobsapiv1.LogpushJobsApiV1
 	cisLogpushJobsErr    error
+	cisLogpushJobsOnce   sync.Once
 
 	// CIS Range app service option
 	cisRangeAppErr    error
+	cisRangeAppOnce   sync.Once
 	cisRangeAppClient *cisrangeappv1.RangeApplicationsV1
 
 	// CIS WAF rule service options
 	cisWAFRuleErr    error
+	cisWAFRuleOnce   sync.Once
 	cisWAFRuleClient *ciswafrulev1.WafRulesApiV1
 	// IAM Identity Option
-	iamIdentityErr error
-	iamIdentityAPI *iamidentity.IamIdentityV1
+	iamIdentityErr  error
+	iamIdentityOnce sync.Once
+	iamIdentityAPI  *iamidentity.IamIdentityV1
 
 	// Resource Manager Option
-	resourceManagerErr error
-	resourceManagerAPI *resourcemanager.ResourceManagerV2
+	resourceManagerErr  error
+	resourceManagerOnce sync.Once
+	resourceManagerAPI  *resourcemanager.ResourceManagerV2
 
 	// Catalog Management Option
-	catalogManagementClient    *catalogmanagementv1.CatalogManagementV1
-	catalogManagementClientErr error
+	catalogManagementClient     *catalogmanagementv1.CatalogManagementV1
+	catalogManagementClientErr  error
+	catalogManagementClientOnce sync.Once
 
-	enterpriseManagementClient    *enterprisemanagementv1.EnterpriseManagementV1
-	enterpriseManagementClientErr error
+	enterpriseManagementClient     *enterprisemanagementv1.EnterpriseManagementV1
+	enterpriseManagementClientErr  error
+	enterpriseManagementClientOnce sync.Once
 
 	// Resource Controller Option
-	resourceControllerErr   error
-	resourceControllerAPI   *resourcecontroller.ResourceControllerV2
-	secretsManagerClientV1  *secretsmanagerv1.SecretsManagerV1
-	secretsManagerClient    *secretsmanagerv2.SecretsManagerV2
-	secretsManagerClientErr error
+	resourceControllerErr    error
+	resourceControllerOnce   sync.Once
+	resourceControllerAPI    *resourcecontroller.ResourceControllerV2
+	secretsManagerClientV1   *secretsmanagerv1.SecretsManagerV1
+	secretsManagerClient     *secretsmanagerv2.SecretsManagerV2
+	secretsManagerClientErr  error
+	secretsManagerClientOnce sync.Once
 
 	// Schematics service options
-	schematicsClient    *schematicsv1.SchematicsV1
-	schematicsClientErr error
+	schematicsClient     *schematicsv1.SchematicsV1
+	schematicsClientErr  error
+	schematicsClientOnce sync.Once
 
 	// Satellite service
-	satelliteClient    *kubernetesserviceapiv1.KubernetesServiceApiV1
-	satelliteClientErr error
+	satelliteClient     *kubernetesserviceapiv1.KubernetesServiceApiV1
+	satelliteClientErr  error
+	satelliteClientOnce sync.Once
 
 	// IAM Policy Management
-	iamPolicyManagementErr error
-	iamPolicyManagementAPI *iampolicymanagement.IamPolicyManagementV1
+	iamPolicyManagementErr  error
+	iamPolicyManagementOnce sync.Once
+	iamPolicyManagementAPI  *iampolicymanagement.IamPolicyManagementV1
 
 	// IAM Access Groups
-	iamAccessGroupsErr error
-	iamAccessGroupsAPI *iamaccessgroups.IamAccessGroupsV2
+	iamAccessGroupsErr  error
+	iamAccessGroupsOnce sync.Once
+	iamAccessGroupsAPI  *iamaccessgroups.IamAccessGroupsV2
 
 	// MTLS Session options
 	cisMtlsClient *cismtlsv1.MtlsV1
 	cisMtlsErr    error
+	cisMtlsOnce   sync.Once
 
 	// Bot Management options
 	cisBotManagementClient *cisbotmanagementv1.BotManagementV1
 	cisBotManagementErr    error
+	cisBotManagementOnce   sync.Once
 
 	// Bot Analytics options
 	cisBotAnalyticsClient *cisbotanalyticsv1.BotAnalyticsV1
 	cisBotAnalyticsErr    error
+	cisBotAnalyticsOnce   sync.Once
 
 	// CIS Webhooks options
 	cisWebhooksClient *ciswebhooksv1.WebhooksV1
 	cisWebhooksErr    error
+	cisWebhooksOnce   sync.Once
 
 	// CIS Filters options
 	cisFiltersClient *cisfiltersv1.FiltersV1
 	cisFiltersErr    error
+	cisFiltersOnce   sync.Once
 
 	// CIS FirewallRules options
 	cisFirewallRulesClient *cisfirewallrulesv1.FirewallRulesV1
 	cisFirewallRulesErr    error
+	cisFirewallRulesOnce   sync.Once
 
 	// Atracker
-	atrackerClientV2    *atrackerv2.AtrackerV2
-	atrackerClientV2Err error
+	atrackerClientV2     *atrackerv2.AtrackerV2
+	atrackerClientV2Err  error
+	atrackerClientV2Once sync.Once
 
 	// Metrics Router
-	metricsRouterClient    *metricsrouterv3.MetricsRouterV3
-	metricsRouterClientErr error
+	metricsRouterClient     *metricsrouterv3.MetricsRouterV3
+	metricsRouterClientErr  error
+	metricsRouterClientOnce sync.Once
 
 	// Satellite link service
-	satelliteLinkClient    *satellitelinkv1.SatelliteLinkV1
-	satelliteLinkClientErr error
+	satelliteLinkClient     *satellitelinkv1.SatelliteLinkV1
+	satelliteLinkClientErr  error
+	satelliteLinkClientOnce sync.Once
 
 	esSchemaRegistryClient *schemaregistryv1.SchemaregistryV1
 	esSchemaRegistryErr    error
+	esSchemaRegistryOnce   sync.Once
 
 	// Security and Compliance Center (SCC)
-	securityAndComplianceCenterClient    *scc.SecurityAndComplianceCenterApiV3
-	securityAndComplianceCenterClientErr error
+	securityAndComplianceCenterClient     *scc.SecurityAndComplianceCenterApiV3
+	securityAndComplianceCenterClientErr  error
+	securityAndComplianceCenterClientOnce sync.Once
 
 	// context Based Restrictions (CBR)
-	contextBasedRestrictionsClient    *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
-	contextBasedRestrictionsClientErr error
+	contextBasedRestrictionsClient     *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
+	contextBasedRestrictionsClientErr  error
+	contextBasedRestrictionsClientOnce sync.Once
 
 	// CD Toolchain
-	cdToolchainClient    *cdtoolchainv2.CdToolchainV2
-	cdToolchainClientErr error
+	cdToolchainClient     *cdtoolchainv2.CdToolchainV2
+	cdToolchainClientErr  error
+	cdToolchainClientOnce sync.Once
 
 	// CD Tekton Pipeline
-	cdTektonPipelineClient    *cdtektonpipelinev2.CdTektonPipelineV2
-	cdTektonPipelineClientErr error
+	cdTektonPipelineClient     *cdtektonpipelinev2.CdTektonPipelineV2
+	cdTektonPipelineClientErr  error
+	cdTektonPipelineClientOnce sync.Once
 
 	// Code Engine options
-	codeEngineClient    *codeengine.CodeEngineV2
-	codeEngineClientErr error
+	codeEngineClient     *codeengine.CodeEngineV2
+	codeEngineClientErr  error
+	codeEngineClientOnce sync.Once
 
 	// Project options
-	projectClient    *project.ProjectV1
-	projectClientErr error
+	projectClient     *project.ProjectV1
+	projectClientErr  error
+	projectClientOnce sync.Once
+}
+
+// DefaultTags provides the provider level tags merged into every taggable resource
+func (session *clientSession) DefaultTags() []string {
+	return session.defaultTags
+}
+
+// AuditEnabled reports whether the API calls of the session are written to an audit log
+func (session *clientSession) AuditEnabled() bool {
+	return session.session != nil && session.session.auditLogger != nil
+}
+
+// LockTimeout is the longest time the resources of the session wait for a lock
+// of IbmLockManager, zero for as long as the timeout of the operation allows
+func (session *clientSession) LockTimeout() time.Duration {
+	if session.config == nil {
+		return DefaultLockTimeout
+	}
+	return session.config.LockTimeout
 }
 
 // AppIDAPI provides AppID Service APIs ...
-func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+func (session *clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+	session.lazyInit(&session.appidOnce, &session.appidErr, session.initAppID)
 	return session.appidAPI, session.appidErr
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.lazyInit(&session.catalogManagementClientOnce, &session.catalogManagementClientErr, session.initCatalogManagement)
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.lazyInit(&sess.accountOnce, &sess.accountConfigErr, sess.initAccount)
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.lazyInit(&sess.accountV1Once, &sess.accountV1ConfigErr, sess.initAccountV1)
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
 	return sess.session.BluemixSession, sess.bluemixSessionErr
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.lazyInit(&sess.csOnce, &sess.csConfigErr, sess.initContainer)
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.lazyInit(&sess.csv2Once, &sess.csv2ConfigErr, sess.initVpcContainer)
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.lazyInit(&session.containerRegistryClientOnce, &session.containerRegistryClientErr, session.initContainerRegistry)
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.lazyInit(&sess.schematicsClientOnce, &sess.schematicsClientErr, sess.initSchematics)
 	if sess.schematicsClientErr != nil {
 		return sess.schematicsClient, sess.schematicsClientErr
 	}
@@ -674,122 +827,145 @@ func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.lazyInit(&sess.globalSearchConfigOnce, &sess.globalSearchConfigErr, sess.initGlobalSearch)
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.lazyInit(&sess.globalTaggingConfigOnce, &sess.globalTaggingConfigErr, sess.initGlobalTagging)
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.lazyInit(&sess.globalTaggingConfigOnceV1, &sess.globalTaggingConfigErrV1, sess.initGlobalTaggingV1)
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // GlobalSearchAPIV2 provides Platform-go Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+func (sess *clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+	sess.lazyInit(&sess.globalSearchConfigOnceV2, &sess.globalSearchConfigErrV2, sess.initGlobalSearchV2)
 	return sess.globalSearchServiceAPIV2, sess.globalSearchConfigErrV2
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.lazyInit(&sess.hpcsEndpointOnce, &sess.hpcsEndpointErr, sess.initHpcsEndpoint)
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // UKO
-func (session clientSession) UkoV4() (*ukov4.UkoV4, error) {
+func (session *clientSession) UkoV4() (*ukov4.UkoV4, error) {
+	session.lazyInit(&session.ukoClientOnce, &session.ukoClientErr, session.initUko)
 	return session.ukoClient, session.ukoClientErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.lazyInit(&sess.userManagementOnce, &sess.userManagementErr, sess.initUserManagement)
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.lazyInit(&sess.iamPolicyManagementOnce, &sess.iamPolicyManagementErr, sess.initIAMPolicyManagement)
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
-func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+func (sess *clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+	sess.lazyInit(&sess.iamAccessGroupsOnce, &sess.iamAccessGroupsErr, sess.initIAMAccessGroups)
 	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
 }
 
 // IBM Cloud Shell
-func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+func (session *clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+	session.lazyInit(&session.ibmCloudShellClientOnce, &session.ibmCloudShellClientErr, session.initIBMCloudShell)
 	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.lazyInit(&sess.icdConfigOnce, &sess.icdConfigErr, sess.initICD)
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // The IBM Cloud Databases API
-func (session clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+func (session *clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+	session.lazyInit(&session.cloudDatabasesClientOnce, &session.cloudDatabasesClientErr, session.initCloudDatabases)
 	return session.cloudDatabasesClient, session.cloudDatabasesClientErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.lazyInit(&sess.cfOnce, &sess.cfConfigErr, sess.initMccp)
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.lazyInit(&sess.resourceCatalogConfigOnce, &sess.resourceCatalogConfigErr, sess.initResourceCatalog)
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.lazyInit(&sess.resourceManagementConfigOncev2, &sess.resourceManagementConfigErrv2, sess.initResourceManagementV2)
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.lazyInit(&sess.resourceControllerConfigOnce, &sess.resourceControllerConfigErr, sess.initResourceControllerV1)
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.lazyInit(&sess.resourceControllerConfigOncev2, &sess.resourceControllerConfigErrv2, sess.initResourceControllerV2)
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
 	return sess.session.SoftLayerSession
 }
 
 // apigatewayAPI provides API Gateway APIs
-func (sess clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+func (sess *clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+	sess.lazyInit(&sess.apigatewayOnce, &sess.apigatewayErr, sess.initAPIGateway)
 	return sess.apigatewayAPI, sess.apigatewayErr
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.lazyInit(&session.pushServiceClientOnce, &session.pushServiceClientErr, session.initPushService)
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+func (session *clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+	session.lazyInit(&session.eventNotificationsApiClientOnce, &session.eventNotificationsApiClientErr, session.initEventNotifications)
 	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.lazyInit(&session.appConfigurationClientOnce, &session.appConfigurationClientErr, session.initAppConfiguration)
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyProtectAPI() (*kp.Client, error) {
+	sess.lazyInit(&sess.kpOnce, &sess.kpErr, sess.initKeyProtect)
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyManagementAPI() (*kp.Client, error) {
+	sess.lazyInit(&sess.kmsOnce, &sess.kmsErr, sess.initKeyManagement)
 	if sess.kmsErr == nil {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
@@ -808,59 +984,69 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 			}
 		}
 
-		kpClient, err := kp.New(*clientConfig, DefaultTransport())
+		kpClient, err := kp.New(*clientConfig, sess.transport())
 		if err != nil {
-			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+			return nil, fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
 		return kpClient, nil
 	}
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.lazyInit(&sess.vpcOnce, &sess.vpcErr, sess.initVpc)
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+func (sess *clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+	sess.lazyInit(&sess.vpcbetaOnce, &sess.vpcbetaErr, sess.initVpcBeta)
 	return sess.vpcBetaAPI, sess.vpcbetaErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.lazyInit(&sess.directlinkOnce, &sess.directlinkErr, sess.initDirectlink)
 	return sess.directlinkAPI, sess.directlinkErr
 }
 
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.lazyInit(&sess.dlProviderOnce, &sess.dlProviderErr, sess.initDirectlinkProvider)
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
 
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.lazyInit(&sess.cosConfigOnce, &sess.cosConfigErr, sess.initCosConfig)
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.lazyInit(&sess.transitgatewayOnce, &sess.transitgatewayErr, sess.initTransitGateway)
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.lazyInit(&sess.ibmpiConfigOnce, &sess.ibmpiConfigErr, sess.initIBMPISession)
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.lazyInit(&sess.pDNSOnce, &sess.pDNSErr, sess.initPrivateDNS)
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.lazyInit(&sess.functionIAMNamespaceOnce, &sess.functionIAMNamespaceErr, sess.initFunctionIAMNamespace)
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.lazyInit(&sess.cisZonesOnce, &sess.cisZonesErr, sess.initCisZones)
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -868,7 +1054,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.lazyInit(&sess.cisDNSOnce, &sess.cisDNSErr, sess.initCisDNSRecords)
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -876,7 +1063,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.lazyInit(&sess.cisDNSBulkOnce, &sess.cisDNSBulkErr, sess.initCisDNSRecordBulk)
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -884,7 +1072,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.lazyInit(&sess.cisGLBPoolOnce, &sess.cisGLBPoolErr, sess.initCisGLBPool)
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -892,7 +1081,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.lazyInit(&sess.cisGLBOnce, &sess.cisGLBErr, sess.initCisGLB)
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -900,7 +1090,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.lazyInit(&sess.cisGLBHealthCheckOnce, &sess.cisGLBHealthCheckErr, sess.initCisGLBHealthCheck)
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -908,7 +1099,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.lazyInit(&sess.cisRLOnce, &sess.cisRLErr, sess.initCisRL)
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -916,7 +1108,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.lazyInit(&sess.cisIPOnce, &sess.cisIPErr, sess.initCisIP)
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -924,7 +1117,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.lazyInit(&sess.cisPageRuleOnce, &sess.cisPageRuleErr, sess.initCisPageRule)
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -932,7 +1126,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.lazyInit(&sess.cisEdgeFunctionOnce, &sess.cisEdgeFunctionErr, sess.initCisEdgeFunction)
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -940,7 +1135,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.lazyInit(&sess.cisSSLOnce, &sess.cisSSLErr, sess.initCisSSL)
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -948,7 +1144,8 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.lazyInit(&sess.cisWAFPackageOnce, &sess.cisWAFPackageErr, sess.initCisWAFPackage)
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -956,7 +1153,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.lazyInit(&sess.cisDomainSettingsOnce, &sess.cisDomainSettingsErr, sess.initCisDomainSettings)
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -964,7 +1162,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Alerts
-func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+func (sess *clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+	sess.lazyInit(&sess.cisAlertsOnce, &sess.cisAlertsErr, sess.initCisAlerts)
 	if sess.cisAlertsErr != nil {
 		return sess.cisAlertsClient, sess.cisAlertsErr
 	}
@@ -972,7 +1171,8 @@ func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.lazyInit(&sess.cisRoutingOnce, &sess.cisRoutingErr, sess.initCisRouting)
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -980,7 +1180,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.lazyInit(&sess.cisWAFGroupOnce, &sess.cisWAFGroupErr, sess.initCisWAFGroup)
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -988,7 +1189,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.lazyInit(&sess.cisCacheOnce, &sess.cisCacheErr, sess.initCisCache)
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -996,7 +1198,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.lazyInit(&sess.cisCustomPageOnce, &sess.cisCustomPageErr, sess.initCisCustomPage)
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -1004,7 +1207,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.lazyInit(&sess.cisAccessRuleOnce, &sess.cisAccessRuleErr, sess.initCisAccessRule)
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -1012,7 +1216,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.lazyInit(&sess.cisUARuleOnce, &sess.cisUARuleErr, sess.initCisUARule)
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -1020,7 +1225,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.lazyInit(&sess.cisLockdownOnce, &sess.cisLockdownErr, sess.initCisLockdown)
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -1028,7 +1234,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.lazyInit(&sess.cisRangeAppOnce, &sess.cisRangeAppErr, sess.initCisRangeApp)
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -1036,7 +1243,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.lazyInit(&sess.cisWAFRuleOnce, &sess.cisWAFRuleErr, sess.initCisWAFRule)
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -1044,7 +1252,8 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // CIS Authenticated Origin Pull
-func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+func (sess *clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+	sess.lazyInit(&sess.cisOriginAuthPullOnce, &sess.cisOriginAuthPullErr, sess.initCisOriginAuth)
 	if sess.cisOriginAuthPullErr != nil {
 		return sess.cisOriginAuthClient, sess.cisOriginAuthPullErr
 	}
@@ -1052,48 +1261,57 @@ func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOrig
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.lazyInit(&sess.iamIdentityOnce, &sess.iamIdentityErr, sess.initIAMIdentity)
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.lazyInit(&sess.resourceManagerOnce, &sess.resourceManagerErr, sess.initResourceManager)
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.lazyInit(&session.enterpriseManagementClientOnce, &session.enterpriseManagementClientErr, session.initEnterpriseManagement)
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.lazyInit(&sess.resourceControllerOnce, &sess.resourceControllerErr, sess.initResourceController)
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
 // IBM Cloud Secrets Manager V1 Basic API
-func (session clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+func (session *clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+	session.lazyInit(&session.secretsManagerClientOnce, &session.secretsManagerClientErr, session.initSecretsManager)
 	return session.secretsManagerClientV1, session.secretsManagerClientErr
 }
 
 // IBM Cloud Secrets Manager V2 Basic API
-func (session clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+func (session *clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+	session.lazyInit(&session.secretsManagerClientOnce, &session.secretsManagerClientErr, session.initSecretsManager)
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 // Satellite Link
-func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+func (session *clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+	session.lazyInit(&session.satelliteLinkClientOnce, &session.satelliteLinkClientErr, session.initSatelliteLink)
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.lazyInit(&sess.satelliteClientOnce, &sess.satelliteClientErr, sess.initSatellite)
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS LogPushJob
-func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+func (sess *clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+	sess.lazyInit(&sess.cisLogpushJobsOnce, &sess.cisLogpushJobsErr, sess.initCisLogpushJobs)
 	if sess.cisLogpushJobsErr != nil {
 		return sess.cisLogpushJobsClient, sess.cisLogpushJobsErr
 	}
@@ -1101,7 +1319,8 @@ func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJ
 }
 
 // CIS MTLS session
-func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+func (sess *clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+	sess.lazyInit(&sess.cisMtlsOnce, &sess.cisMtlsErr, sess.initCisMtls)
 	if sess.cisMtlsErr != nil {
 		return sess.cisMtlsClient, sess.cisMtlsErr
 	}
@@ -1109,7 +1328,8 @@ func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
 }
 
 // CIS Bot Management
-func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+func (sess *clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+	sess.lazyInit(&sess.cisBotManagementOnce, &sess.cisBotManagementErr, sess.initCisBotManagement)
 	if sess.cisBotManagementErr != nil {
 		return sess.cisBotManagementClient, sess.cisBotManagementErr
 	}
@@ -1117,7 +1337,8 @@ func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotMana
 }
 
 // CIS Bot Analytics
-func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+func (sess *clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+	sess.lazyInit(&sess.cisBotAnalyticsOnce, &sess.cisBotAnalyticsErr, sess.initCisBotAnalytics)
 	if sess.cisBotAnalyticsErr != nil {
 		return sess.cisBotAnalyticsClient, sess.cisBotAnalyticsErr
 	}
@@ -1125,7 +1346,8 @@ func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyt
 }
 
 // CIS Webhooks
-func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+func (sess *clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+	sess.lazyInit(&sess.cisWebhooksOnce, &sess.cisWebhooksErr, sess.initCisWebhooks)
 	if sess.cisWebhooksErr != nil {
 		return sess.cisWebhooksClient, sess.cisWebhooksErr
 	}
@@ -1133,7 +1355,8 @@ func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error)
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.lazyInit(&sess.cisFiltersOnce, &sess.cisFiltersErr, sess.initCisFilters)
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
@@ -1141,7 +1364,8 @@ func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
 }
 
 // CIS FirewallRules
-func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+func (sess *clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	sess.lazyInit(&sess.cisFirewallRulesOnce, &sess.cisFirewallRulesErr, sess.initCisFirewallRules)
 	if sess.cisFirewallRulesErr != nil {
 		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
 	}
@@ -1149,142 +1373,80 @@ func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.Firewal
 }
 
 // Activity Tracker API
-func (session clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+func (session *clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+	session.lazyInit(&session.atrackerClientV2Once, &session.atrackerClientV2Err, session.initAtracker)
 	return session.atrackerClientV2, session.atrackerClientV2Err
 }
 
 // Metrics Router API Version 3
-func (session clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+func (session *clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+	session.lazyInit(&session.metricsRouterClientOnce, &session.metricsRouterClientErr, session.initMetricsRouter)
 	return session.metricsRouterClient, session.metricsRouterClientErr
 }
 
-func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+func (session *clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+	session.lazyInit(&session.esSchemaRegistryOnce, &session.esSchemaRegistryErr, session.initESSchemaRegistry)
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
 // Security and Compliance center Admin API
-func (session clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+func (session *clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+	session.lazyInit(&session.securityAndComplianceCenterClientOnce, &session.securityAndComplianceCenterClientErr, session.initSecurityAndComplianceCenter)
 	return session.securityAndComplianceCenterClient, session.securityAndComplianceCenterClientErr
 }
 
 // Context Based Restrictions
-func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+func (session *clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	session.lazyInit(&session.contextBasedRestrictionsClientOnce, &session.contextBasedRestrictionsClientErr, session.initContextBasedRestrictions)
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
 // CD Toolchain
-func (session clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+func (session *clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+	session.lazyInit(&session.cdToolchainClientOnce, &session.cdToolchainClientErr, session.initCdToolchain)
 	return session.cdToolchainClient, session.cdToolchainClientErr
 }
 
 // CD Tekton Pipeline
-func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+func (session *clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+	session.lazyInit(&session.cdTektonPipelineClientOnce, &session.cdTektonPipelineClientErr, session.initCdTektonPipeline)
 	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
 }
 
 // Code Engine
-func (session clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+func (session *clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+	session.lazyInit(&session.codeEngineClientOnce, &session.codeEngineClientErr, session.initCodeEngine)
 	return session.codeEngineClient, session.codeEngineClientErr
 }
 
 // Projects API Specification
-func (session clientSession) ProjectV1() (*project.ProjectV1, error) {
+func (session *clientSession) ProjectV1() (*project.ProjectV1, error) {
+	session.lazyInit(&session.projectClientOnce, &session.projectClientErr, session.initProject)
 	return session.projectClient, session.projectClientErr
 }
 
-// ClientSession configures and returns a fully initialized ClientSession
+// ClientSession configures and returns a ClientSession. The service clients
+// are built on first use by their accessor, see lazyInit.
 func (c *Config) ClientSession() (interface{}, error) {
 	sess, err := newSession(c)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
-	session := clientSession{
-		session: sess,
+	session := &clientSession{
+		session:     sess,
+		config:      c,
+		defaultTags: c.DefaultTags,
+		retryPolicy: c.retryPolicy(),
 	}
+	retryPolicy := session.retryPolicy
 
 	if sess.BluemixSession == nil {
 		// Can be nil only  if bluemix_api_key is not provided
 		log.Println("Skipping Bluemix Clients configuration")
 		session.bluemixSessionErr = errEmptyBluemixCredentials
-		session.accountConfigErr = errEmptyBluemixCredentials
-		session.accountV1ConfigErr = errEmptyBluemixCredentials
-		session.csConfigErr = errEmptyBluemixCredentials
-		session.csv2ConfigErr = errEmptyBluemixCredentials
-		session.containerRegistryClientErr = errEmptyBluemixCredentials
-		session.kpErr = errEmptyBluemixCredentials
-		session.pushServiceClientErr = errEmptyBluemixCredentials
-		session.appConfigurationClientErr = errEmptyBluemixCredentials
-		session.kmsErr = errEmptyBluemixCredentials
-		session.cfConfigErr = errEmptyBluemixCredentials
-		session.cisConfigErr = errEmptyBluemixCredentials
-		session.functionConfigErr = errEmptyBluemixCredentials
-		session.globalSearchConfigErr = errEmptyBluemixCredentials
-		session.globalTaggingConfigErr = errEmptyBluemixCredentials
-		session.globalTaggingConfigErrV1 = errEmptyBluemixCredentials
-		session.hpcsEndpointErr = errEmptyBluemixCredentials
-		session.iamAccessGroupsErr = errEmptyBluemixCredentials
-		session.icdConfigErr = errEmptyBluemixCredentials
-		session.resourceCatalogConfigErr = errEmptyBluemixCredentials
-		session.resourceManagerErr = errEmptyBluemixCredentials
-		session.resourceManagementConfigErrv2 = errEmptyBluemixCredentials
-		session.resourceControllerConfigErr = errEmptyBluemixCredentials
-		session.resourceControllerConfigErrv2 = errEmptyBluemixCredentials
-		session.enterpriseManagementClientErr = errEmptyBluemixCredentials
-		session.resourceControllerErr = errEmptyBluemixCredentials
-		session.catalogManagementClientErr = errEmptyBluemixCredentials
-		session.ibmpiConfigErr = errEmptyBluemixCredentials
-		session.userManagementErr = errEmptyBluemixCredentials
-		session.vpcErr = errEmptyBluemixCredentials
-		session.vpcbetaErr = errEmptyBluemixCredentials
-		session.apigatewayErr = errEmptyBluemixCredentials
-		session.pDNSErr = errEmptyBluemixCredentials
 		session.bmxUserFetchErr = errEmptyBluemixCredentials
-		session.directlinkErr = errEmptyBluemixCredentials
-		session.dlProviderErr = errEmptyBluemixCredentials
-		session.cosConfigErr = errEmptyBluemixCredentials
-		session.transitgatewayErr = errEmptyBluemixCredentials
-		session.functionIAMNamespaceErr = errEmptyBluemixCredentials
-		session.cisDNSErr = errEmptyBluemixCredentials
-		session.cisAlertsErr = errEmptyBluemixCredentials
-		session.cisDNSBulkErr = errEmptyBluemixCredentials
-		session.cisGLBPoolErr = errEmptyBluemixCredentials
-		session.cisGLBErr = errEmptyBluemixCredentials
-		session.cisGLBHealthCheckErr = errEmptyBluemixCredentials
-		session.cisIPErr = errEmptyBluemixCredentials
-		session.cisZonesErr = errEmptyBluemixCredentials
-		session.cisRLErr = errEmptyBluemixCredentials
-		session.cisPageRuleErr = errEmptyBluemixCredentials
-		session.cisEdgeFunctionErr = errEmptyBluemixCredentials
-		session.cisSSLErr = errEmptyBluemixCredentials
-		session.cisWAFPackageErr = errEmptyBluemixCredentials
-		session.cisDomainSettingsErr = errEmptyBluemixCredentials
-		session.cisRoutingErr = errEmptyBluemixCredentials
-		session.cisWAFGroupErr = errEmptyBluemixCredentials
-		session.cisCacheErr = errEmptyBluemixCredentials
-		session.cisCustomPageErr = errEmptyBluemixCredentials
-		session.cisMtlsErr = errEmptyBluemixCredentials
-		session.cisAccessRuleErr = errEmptyBluemixCredentials
-		session.cisUARuleErr = errEmptyBluemixCredentials
-		session.cisLockdownErr = errEmptyBluemixCredentials
-		session.cisRangeAppErr = errEmptyBluemixCredentials
-		session.cisWAFRuleErr = errEmptyBluemixCredentials
-		session.iamIdentityErr = errEmptyBluemixCredentials
-		session.secretsManagerClientErr = errEmptyBluemixCredentials
-		session.cisFiltersErr = errEmptyBluemixCredentials
-		session.cisWebhooksErr = errEmptyBluemixCredentials
-		session.cisLogpushJobsErr = errEmptyBluemixCredentials
-		session.schematicsClientErr = errEmptyBluemixCredentials
-		session.satelliteClientErr = errEmptyBluemixCredentials
-		session.iamPolicyManagementErr = errEmptyBluemixCredentials
-		session.satelliteLinkClientErr = errEmptyBluemixCredentials
-		session.esSchemaRegistryErr = errEmptyBluemixCredentials
-		session.contextBasedRestrictionsClientErr = errEmptyBluemixCredentials
-		session.securityAndComplianceCenterClientErr = errEmptyBluemixCredentials
-		session.cdTektonPipelineClientErr = errEmptyBluemixCredentials
-		session.cdToolchainClientErr = errEmptyBluemixCredentials
-		session.codeEngineClientErr = errEmptyBluemixCredentials
-		session.projectClientErr = errEmptyBluemixCredentials
+		session.functionConfigErr = errEmptyBluemixCredentials
 
 		return session, nil
 	}
@@ -1292,12 +1454,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if sess.BluemixSession.Config.BluemixAPIKey != "" {
 		err = authenticateAPIKey(sess.BluemixSession)
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
+			for attempt := 0; attempt < retryPolicy.MaxAttempts-1; attempt++ {
+				if err == nil || !retryPolicy.IsRetryable(err) {
 					break
 				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying IAM Authentication %d", count)
+				time.Sleep(retryPolicy.Delay(attempt))
+				log.Printf("Retrying IAM Authentication %d", attempt+1)
 				err = authenticateAPIKey(sess.BluemixSession)
 			}
 			if err != nil {
@@ -1307,12 +1469,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		err = authenticateCF(sess.BluemixSession)
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
+			for attempt := 0; attempt < retryPolicy.MaxAttempts-1; attempt++ {
+				if err == nil || !retryPolicy.IsRetryable(err) {
 					break
 				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying CF Authentication %d", count)
+				time.Sleep(retryPolicy.Delay(attempt))
+				log.Printf("Retrying CF Authentication %d", attempt+1)
 				err = authenticateCF(sess.BluemixSession)
 			}
 			if err != nil {
@@ -1324,12 +1486,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.IAMTrustedProfileID == "" && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
 		err := RefreshToken(sess.BluemixSession)
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
+			for attempt := 0; attempt < retryPolicy.MaxAttempts-1; attempt++ {
+				if err == nil || !retryPolicy.IsRetryable(err) {
 					break
 				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying refresh token %d", count)
+				time.Sleep(retryPolicy.Delay(attempt))
+				log.Printf("Retrying refresh token %d", attempt+1)
 				err = RefreshToken(sess.BluemixSession)
 			}
 			if err != nil {
@@ -1353,58 +1515,183 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
 
 	BluemixRegion = sess.BluemixSession.Config.Region
-	var fileMap map[string]interface{}
+	var fileMap EndpointsFile
 	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
+		fileMap, err = LoadEndpointsFile(f, c.Region, c.PrivateEndpointsOnly)
 		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
+			return nil, err
 		}
-		defer jsonFile.Close()
-		bytes, err := ioutil.ReadAll(jsonFile)
-		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
+	}
+	session.fileMap = fileMap
+
+	iamURL := iamidentity.DefaultServiceURL
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		if c.Region == "us-south" || c.Region == "us-east" {
+			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		} else {
+			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
-		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
+	}
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
+	}
+
+	var authenticator core.Authenticator
+
+	// The token requests go through the session transport as well, so that
+	// they follow private_endpoints_only like the service requests.
+	tokenClient := &gohttp.Client{Transport: session.transport(), Timeout: 30 * time.Second}
+	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
+		if c.BluemixAPIKey != "" {
+			authenticator = &core.IamAuthenticator{
+				ApiKey: c.BluemixAPIKey,
+				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+				Client: tokenClient,
+			}
+		} else {
+			// Construct the IamAuthenticator with the IAM refresh token.
+			authenticator = &core.IamAuthenticator{
+				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
+				ClientId:     "bx",
+				ClientSecret: "bx",
+				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+				Client:       tokenClient,
+			}
+		}
+	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
+		}
+	} else {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
+		}
+	}
+
+	session.iamURL = iamURL
+	session.authenticator = authenticator
+
+	if os.Getenv("TF_LOG") != "" {
+		logDestination := log.Writer()
+		goLogger := log.New(logDestination, "", log.LstdFlags)
+		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
+	}
+
+	// setting UserAgent for vpc-go-sdk common
+	common.UserAgent = fmt.Sprintf("terraform-provider-ibm/%s", version.Version)
+	return session, nil
+}
+
+// lazyInit builds the clients of init the first time one of them is requested.
+// Without IBM Cloud credentials no client can be built and err is set instead.
+func (session *clientSession) lazyInit(once *sync.Once, err *error, init func()) {
+	once.Do(func() {
+		if session.session.BluemixSession == nil {
+			*err = errEmptyBluemixCredentials
+			return
 		}
+		init()
+	})
+}
+
+// configureService sends the requests of a go-sdk-core service through the
+// shared connection pool, following the provider retry policy.
+func (session *clientSession) configureService(service *core.BaseService) {
+	service.SetHTTPClient(&gohttp.Client{Transport: session.transport()})
+	session.retryPolicy.Apply(service)
+}
+
+// transport returns the HTTP transport of the session, which enforces
+// private_endpoints_only when it is set.
+func (session *clientSession) transport() gohttp.RoundTripper {
+	if session.session != nil && session.session.transport != nil {
+		return session.session.transport
+	}
+	return DefaultTransport()
+}
+
+// cisEndpoint returns the endpoint shared by the CIS service clients. CIS has
+// no private endpoint, the public one is used with every visibility.
+func (session *clientSession) cisEndpoint() string {
+	c := session.config
+	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		cisURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
+	}
+	return EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+}
+
+// vpcURL returns the endpoint shared by the VPC and VPC beta service clients.
+func (session *clientSession) vpcURL() string {
+	c := session.config
+	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+	}
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		vpcurl = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
 	}
+	return vpcurl
+}
+
+func (session *clientSession) initAccountV1() {
+	sess := session.session
 	accv1API, err := accountv1.New(sess.BluemixSession)
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
 	}
 	session.bmxAccountv1ServiceAPI = accv1API
+}
 
+func (session *clientSession) initAccount() {
+	sess := session.session
 	accAPI, err := accountv2.New(sess.BluemixSession)
 	if err != nil {
 		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
 	}
 	session.bmxAccountServiceAPI = accAPI
+}
 
+func (session *clientSession) initMccp() {
+	sess := session.session
 	cfAPI, err := mccpv2.New(sess.BluemixSession)
 	if err != nil {
 		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
 	}
 	session.cfServiceAPI = cfAPI
+}
 
+func (session *clientSession) initContainer() {
+	sess := session.session
 	clusterAPI, err := containerv1.New(sess.BluemixSession)
 	if err != nil {
 		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
 	}
 	session.csServiceAPI = clusterAPI
+}
 
+func (session *clientSession) initVpcContainer() {
+	sess := session.session
 	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
 	if err != nil {
 		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
 	}
 	session.csv2ServiceAPI = v2clusterAPI
+}
 
+func (session *clientSession) initHpcsEndpoint() {
+	sess := session.session
 	hpcsAPI, err := hpcs.New(sess.BluemixSession)
 	if err != nil {
 		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
 	}
 	session.hpcsEndpointAPI = hpcsAPI
+}
 
+func (session *clientSession) initKeyProtect() {
+	c := session.config
+	sess := session.session
+	fileMap := session.fileMap
 	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
@@ -1428,25 +1715,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
+	kpAPIclient, err := kp.New(options, session.transport())
 	if err != nil {
 		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 	}
 	session.kpAPI = kpAPIclient
+}
 
-	iamURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
-	}
-
+func (session *clientSession) initKeyManagement() {
 	// KEY MANAGEMENT Service
+	c := session.config
+	sess := session.session
+	fileMap := session.fileMap
+	iamURL := session.iamURL
 	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
@@ -1472,39 +1753,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
 		}
 	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+	kmsAPIclient, err := kp.New(kmsOptions, session.transport())
 	if err != nil {
 		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
 	}
 	session.kmsAPI = kmsAPIclient
+}
 
-	var authenticator core.Authenticator
-
-	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
-		if c.BluemixAPIKey != "" {
-			authenticator = &core.IamAuthenticator{
-				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		} else {
-			// Construct the IamAuthenticator with the IAM refresh token.
-			authenticator = &core.IamAuthenticator{
-				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
-				ClientId:     "bx",
-				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		}
-	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
-		}
-	} else {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
-		}
-	}
-
+func (session *clientSession) initProject() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	projectEndpoint := project.DefaultServiceURL
 	// Construct an "options" struct for creating the service client.
 	if fileMap != nil && c.Visibility != "public-and-private" {
@@ -1523,7 +1783,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.projectClient, err = project.NewProjectV1(projectClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.projectClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.projectClient.Service)
 		// Add custom header for analytics
 		session.projectClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1531,8 +1791,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.projectClientErr = fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
 	}
+}
 
+func (session *clientSession) initUko() {
 	// Construct an "options" struct for creating the service client.
+	authenticator := session.authenticator
+	var err error
 	ukoClientOptions := &ukov4.UkoV4Options{
 		Authenticator: authenticator,
 	}
@@ -1541,7 +1805,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.ukoClient.Service)
 		// Add custom header for analytics
 		session.ukoClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1549,8 +1813,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
 	}
+}
 
+func (session *clientSession) initAppID() {
 	// APPID Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
 	if c.Visibility == "private" {
 		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
@@ -1567,14 +1836,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
 	}
 	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(appIDClient.Service)
 		appIDClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.appidAPI = appIDClient
+}
 
+func (session *clientSession) initContextBasedRestrictions() {
 	// Construct an "options" struct for creating Context Based Restrictions service client.
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-de" {
@@ -1595,7 +1870,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
 	if err == nil && session.contextBasedRestrictionsClient != nil {
 		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.contextBasedRestrictionsClient.Service)
 		// Add custom header for analytics
 		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1603,8 +1878,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
 	}
+}
 
+func (session *clientSession) initCatalogManagement() {
 	// CATALOG MANAGEMENT Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
 	if c.Visibility == "private" {
 		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
@@ -1623,14 +1904,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
 		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.catalogManagementClient.Service)
 		// Add custom header for analytics
 		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initAtracker() {
 	// ATRACKER Version 2
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	var atrackerClientV2URL string
 	var atrackerURLV2Err error
 
@@ -1660,7 +1947,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
 	if err == nil {
 		// Enable retries for API calls
-		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.atrackerClientV2.Service)
 		// Add custom header for analytics
 		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1668,8 +1955,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
 	}
+}
 
+func (session *clientSession) initMetricsRouter() {
 	// Construct an "options" struct for creating the service client for Metrics Router
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	var metricsRouterClientURL string
 	var metricsRouterURLV3Err error
 
@@ -1696,7 +1989,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.metricsRouterClient, err = metricsrouterv3.NewMetricsRouterV3(metricsRouterClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.metricsRouterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.metricsRouterClient.Service)
 		// Add custom header for analytics
 		session.metricsRouterClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1704,8 +1997,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.metricsRouterClientErr = fmt.Errorf("Error occurred while configuring Metrics Router API Version 3 service: %q", err)
 	}
+}
 
+func (session *clientSession) initSecurityAndComplianceCenter() {
 	// SCC Service
+	authenticator := session.authenticator
+	var err error
 	sccApiClientURL := scc.DefaultServiceURL
 	// Construct the service options.
 
@@ -1718,7 +2015,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.securityAndComplianceCenterClient, err = scc.NewSecurityAndComplianceCenterApiV3(sccApiClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.securityAndComplianceCenterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.securityAndComplianceCenterClient.Service)
 		// Add custom header for analytics
 		session.securityAndComplianceCenterClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1726,9 +2023,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.securityAndComplianceCenterClientErr = fmt.Errorf("Error occurred while configuring Config Manager service: %q", err)
 	}
+}
 
+func (session *clientSession) initSchematics() {
 	// SCHEMATICS Service
 	// schematicsEndpoint := "https://schematics.cloud.ibm.com"
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	schematicsEndpoint := ContructEndpoint(fmt.Sprintf("%s.schematics", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
@@ -1747,21 +2049,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(schematicsClient.Service)
 		schematicsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.schematicsClient = schematicsClient
+}
 
+func (session *clientSession) initVpc() {
 	// VPC Service
-	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
-	}
+	authenticator := session.authenticator
+	vpcurl := session.vpcURL()
 	vpcoptions := &vpc.VpcV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
 		Authenticator: authenticator,
@@ -1771,13 +2070,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(vpcclient.Service)
 		vpcclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.vpcAPI = vpcclient
+}
 
+func (session *clientSession) initVpcBeta() {
+	// VPC BETA Service
+	authenticator := session.authenticator
+	vpcurl := session.vpcURL()
 	vpcbetaoptions := &vpcbeta.VpcbetaV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
 		Authenticator: authenticator,
@@ -1787,14 +2091,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcbetaErr = fmt.Errorf("[ERROR] Error occured while configuring vpc beta service: %q", err)
 	}
 	if vpcbetaclient != nil && vpcbetaclient.Service != nil {
-		vpcbetaclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(vpcbetaclient.Service)
 		vpcbetaclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.vpcBetaAPI = vpcbetaclient
+}
 
+func (session *clientSession) initPushService() {
 	// PUSH NOTIFICATIONS Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
 	if c.Visibility == "private" {
 		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
@@ -1812,13 +2121,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if pnclient != nil && pnclient.Service != nil {
 		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(pnclient.Service)
 		pnclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.pushServiceClient = pnclient
+}
+
+func (session *clientSession) initEventNotifications() {
 	// event notifications
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
 	if c.Visibility == "private" {
 		session.eventNotificationsApiClientErr = fmt.Errorf("Event Notifications Service does not support private endpoints")
@@ -1838,13 +2154,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
 		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.eventNotificationsApiClient.Service)
 		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initAppConfiguration() {
 	// APP CONFIGURATION Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
@@ -1860,14 +2181,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
 	if appConfigClient != nil {
 		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(appConfigClient.Service)
 		session.appConfigurationClient = appConfigClient
 	} else {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
 	}
+}
 
+func (session *clientSession) initContainerRegistry() {
 	// CONTAINER REGISTRY Service
 	// Construct an "options" struct for creating the service client.
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	userConfig := session.bmxUserDetails
 	containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
 	if err != nil {
 		containerRegistryClientURL = containerregistryv1.DefaultServiceURL
@@ -1893,14 +2220,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
 		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.containerRegistryClient.Service)
 		// Add custom header for analytics
 		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCosConfig() {
 	// OBJECT STORAGE Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
@@ -1914,20 +2246,32 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
 	}
 	session.cosConfigAPI = cosconfigclient
+}
 
+func (session *clientSession) initGlobalSearch() {
+	sess := session.session
 	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
 	if err != nil {
 		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
 	}
 	session.globalSearchServiceAPI = globalSearchAPI
+}
+
+func (session *clientSession) initGlobalTagging() {
 	// Global Tagging Bluemix-go
+	sess := session.session
 	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
 	if err != nil {
 		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
 	}
 	session.globalTaggingServiceAPI = globalTaggingAPI
+}
 
+func (session *clientSession) initGlobalTaggingV1() {
 	// GLOBAL TAGGING Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		var globalTaggingRegion string
@@ -1951,12 +2295,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.globalTaggingServiceAPIV1.Service)
 		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) initGlobalSearchV2() {
 	// GLOBAL TAGGING Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		var globalSearchRegion string
@@ -1976,22 +2326,30 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
 	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		session.globalSearchConfigErrV2 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
 	}
 	if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
 		session.globalSearchServiceAPIV2 = *globalSearchAPIV2
-		session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.globalSearchServiceAPIV2.Service)
 		session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initICD() {
+	sess := session.session
 	icdAPI, err := icdv4.New(sess.BluemixSession)
 	if err != nil {
 		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
 	}
 	session.icdServiceAPI = icdAPI
+}
 
+func (session *clientSession) initCloudDatabases() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	var cloudDatabasesEndpoint string
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2010,7 +2368,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cloudDatabasesClient.Service)
 		// Add custom header for analytics
 		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2018,44 +2376,66 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
 	}
+}
 
+func (session *clientSession) initResourceCatalog() {
+	sess := session.session
 	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
 	}
 	session.resourceCatalogServiceAPI = resourceCatalogAPI
+}
 
+func (session *clientSession) initResourceManagementV2() {
+	sess := session.session
 	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
 	}
 	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+}
 
+func (session *clientSession) initResourceControllerV1() {
+	sess := session.session
 	resourceControllerAPI, err := controller.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	session.resourceControllerServiceAPI = resourceControllerAPI
+}
 
+func (session *clientSession) initResourceControllerV2() {
+	sess := session.session
 	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
 	}
 	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+}
 
+func (session *clientSession) initUserManagement() {
+	sess := session.session
 	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
 	if err != nil {
 		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
 	}
 	session.userManagementAPI = userManagementAPI
+}
 
+func (session *clientSession) initFunctionIAMNamespace() {
+	sess := session.session
 	namespaceFunction, err := functions.New(sess.BluemixSession)
 	if err != nil {
 		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
 	}
 	session.functionIAMNamespaceAPI = namespaceFunction
+}
 
+func (session *clientSession) initAPIGateway() {
 	//  API GATEWAY service
+	c := session.config
+	fileMap := session.fileMap
 	apicurl := ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		apicurl = ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
@@ -2072,8 +2452,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
 	}
 	session.apigatewayAPI = apigatewayAPI
+}
 
+func (session *clientSession) initIBMPISession() {
 	// POWER SYSTEMS Service
+	c := session.config
+	authenticator := session.authenticator
+	userConfig := session.bmxUserDetails
 	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
 	ibmPIOptions := &ibmpisession.IBMPIOptions{
 		Authenticator: authenticator,
@@ -2088,8 +2473,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
 	}
 	session.ibmpiSession = ibmpisession
+}
 
+func (session *clientSession) initPrivateDNS() {
 	// PRIVATE DNS Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	pdnsURL := dns.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
@@ -2106,13 +2496,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.pDNSClient.Service)
 		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initDirectlink() {
 	// DIRECT LINK Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	ver := time.Now().Format("2006-01-02")
 	dlURL := dl.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2131,13 +2526,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.directlinkAPI.Service)
 		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initDirectlinkProvider() {
 	// DIRECT LINK PROVIDER Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	ver := time.Now().Format("2006-01-02")
 	dlproviderURL := dlProviderV2.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
@@ -2155,13 +2556,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.dlProviderAPI.Service)
 		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initTransitGateway() {
 	// TRANSIT GATEWAY Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	tgURL := tg.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
@@ -2179,48 +2585,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.transitgatewayAPI.Service)
 		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
 		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		// })
 	}
+}
 
-	// CIS Service instances starts here.
-	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
-	if c.Visibility == "private" {
-		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
-		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
-	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
-
+func (session *clientSession) initCisZones() {
 	// IBM Network CIS Zones service
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2233,13 +2608,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisZonesErr)
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisZonesV1Client.Service)
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisDNSRecords() {
 	// IBM Network CIS DNS Record service
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2251,13 +2630,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisDNSRecordsClient.Service)
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisDNSRecordBulk() {
 	// IBM Network CIS DNS Record bulk service
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2271,13 +2654,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDNSBulkErr)
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisDNSRecordBulkClient.Service)
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisGLBPool() {
 	// IBM Network CIS Global load balancer pool
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2289,13 +2676,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBPoolErr)
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisGLBPoolClient.Service)
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisGLB() {
 	// IBM Network CIS Global load balancer
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
 		URL:            cisEndPoint,
 		Authenticator:  authenticator,
@@ -2308,13 +2699,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBErr)
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisGLBClient.Service)
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisGLBHealthCheck() {
 	// IBM Network CIS Global load balancer health check/monitor
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2326,13 +2721,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBHealthCheckErr)
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisGLBHealthCheckClient.Service)
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisIP() {
 	// IBM Network CIS IP
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisIPOpt := &cisipv1.CisIpApiV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -2343,13 +2742,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisIPErr)
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisIPClient.Service)
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisRL() {
 	// IBM Network CIS Zone Rate Limit
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2363,12 +2766,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRLErr)
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisRLClient.Service)
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) initCisAlerts() {
 	// IBM Network CIS Alerts
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisAlertsOpt := &cisalertsv1.AlertsV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2380,13 +2788,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisAlertsErr)
 	}
 	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
-		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisAlertsClient.Service)
 		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisPageRule() {
 	// IBM Network CIS Page Rules
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2400,13 +2812,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisPageRuleErr)
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisPageRuleClient.Service)
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisEdgeFunction() {
 	// IBM Network CIS Edge Function
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2419,13 +2835,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisEdgeFunctionErr)
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisEdgeFunctionClient.Service)
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisSSL() {
 	// IBM Network CIS SSL certificate
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2439,13 +2859,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisSSLErr)
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisSSLClient.Service)
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisWAFPackage() {
 	// IBM Network CIS WAF Package
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2458,13 +2882,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFPackageErr)
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisWAFPackageClient.Service)
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisDomainSettings() {
 	// IBM Network CIS Domain settings
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2477,13 +2905,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDomainSettingsErr)
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisDomainSettingsClient.Service)
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisRouting() {
 	// IBM Network CIS Routing
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2496,13 +2928,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRoutingErr)
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisRoutingClient.Service)
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisWAFGroup() {
 	// IBM Network CIS WAF Group
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2515,13 +2951,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFGroupErr)
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisWAFGroupClient.Service)
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisCache() {
 	// IBM Network CIS Cache service
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisCacheOpt := &ciscachev1.CachingApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2534,13 +2974,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisCacheErr)
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisCacheClient.Service)
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisCustomPage() {
 	// IBM Network CIS Custom pages service
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2554,13 +2998,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisCustomPageErr)
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisCustomPageClient.Service)
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisAccessRule() {
 	// IBM Network CIS Firewall Access rule
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2573,13 +3021,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisAccessRuleErr)
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisAccessRuleClient.Service)
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisUARule() {
 	// IBM Network CIS Firewall User Agent Blocking rule
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2592,13 +3044,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisUARuleErr)
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisUARuleClient.Service)
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisLockdown() {
 	// IBM Network CIS Firewall Lockdown rule
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2611,13 +3067,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisLockdownErr)
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisLockdownClient.Service)
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisRangeApp() {
 	// IBM Network CIS Range Application rule
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2630,13 +3090,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRangeAppErr)
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisRangeAppClient.Service)
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisWAFRule() {
 	// IBM Network CIS WAF Rule Service
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2650,13 +3114,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFRuleErr)
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisWAFRuleClient.Service)
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisLogpushJobs() {
 	// IBM Network CIS LogpushJobs
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2670,13 +3138,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisLogpushJobsErr)
 	}
 	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
-		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisLogpushJobsClient.Service)
 		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisMtls() {
 	// IBM MTLS Session
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisMtlsOpt := &cismtlsv1.MtlsV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2688,13 +3160,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisMtlsErr)
 	}
 	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
-		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisMtlsClient.Service)
 		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisBotManagement() {
 	// IBM Bot Management
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisBotManagementOpt := &cisbotmanagementv1.BotManagementV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2707,13 +3183,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisBotManagementErr)
 	}
 	if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
-		session.cisBotManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisBotManagementClient.Service)
 		session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisBotAnalytics() {
 	// IBM Bot Analytics
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisBotAnalyticsOpt := &cisbotanalyticsv1.BotAnalyticsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2726,13 +3206,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisBotAnalyticsErr)
 	}
 	if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
-		session.cisBotAnalyticsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisBotAnalyticsClient.Service)
 		session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisWebhooks() {
 	// IBM Network CIS Webhooks
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2744,12 +3228,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWebhooksErr)
 	}
 	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
-		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisWebhooksClient.Service)
 		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) initCisFilters() {
 	// IBM Network CIS Filters
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -2760,13 +3249,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisFiltersErr)
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisFiltersClient.Service)
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisFirewallRules() {
 	// IBM Network CIS Firewall rules
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -2777,13 +3270,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisFirewallRulesErr)
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisFirewallRulesClient.Service)
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisOriginAuth() {
 	// IBM Network CIS Authenticated Origin Pull
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
 		URL:            cisEndPoint,
 		Authenticator:  authenticator,
@@ -2798,14 +3295,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisOriginAuthPullErr)
 	}
 	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
-		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cisOriginAuthClient.Service)
 		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initIAMIdentity() {
 	// IAM IDENTITY Service
 	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	iamIdenityURL := iamidentity.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2826,14 +3328,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(iamIdentityClient.Service)
 		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamIdentityAPI = iamIdentityClient
+}
 
+func (session *clientSession) initIAMPolicyManagement() {
 	// IAM POLICY MANAGEMENT Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2854,14 +3361,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(iamPolicyManagementClient.Service)
 		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamPolicyManagementAPI = iamPolicyManagementClient
+}
 
+func (session *clientSession) initIAMAccessGroups() {
 	// IAM ACCESS GROUP
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2882,14 +3394,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
 	}
 	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(iamAccessGroupsClient.Service)
 		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamAccessGroupsAPI = iamAccessGroupsClient
+}
 
+func (session *clientSession) initResourceManager() {
 	// RESOURCE MANAGEMENT Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	rmURL := resourcemanager.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2918,14 +3435,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
 	}
 	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(resourceManagerClient.Service)
 		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceManagerAPI = resourceManagerClient
+}
 
+func (session *clientSession) initIBMCloudShell() {
 	// CLOUD SHELL Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
@@ -2939,13 +3462,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
 	}
 	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.ibmCloudShellClient.Service)
 		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initEnterpriseManagement() {
 	// ENTERPRISE Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
@@ -2975,14 +3503,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
 	}
 	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(enterpriseManagementClient.Service)
 		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
+}
 
+func (session *clientSession) initResourceController() {
 	// RESOURCE CONTROLLER Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	rcURL := resourcecontroller.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -3011,14 +3544,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(resourceControllerClient.Service)
 		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceControllerAPI = resourceControllerClient
+}
 
+func (session *clientSession) initSecretsManager() {
 	// SECRETS MANAGER Service
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	secretsManagerClientOptions := &secretsmanagerv1.SecretsManagerV1Options{
 		Authenticator: authenticator,
 	}
@@ -3029,7 +3567,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.secretsManagerClient != nil && session.secretsManagerClient.Service != nil {
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.secretsManagerClient.Service)
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3054,7 +3592,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.secretsManagerClient, err = secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
 	if err == nil {
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.secretsManagerClient.Service)
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3062,8 +3600,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.secretsManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager Basic API service: %q", err)
 	}
+}
 
+func (session *clientSession) initSatellite() {
 	// SATELLITE Service
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
@@ -3082,14 +3626,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// Enable retries for API calls
 	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.satelliteClient.Service)
 		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initSatelliteLink() {
 	// SATELLITE LINK Service
 	// Construct an "options" struct for creating the service client.
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
@@ -3107,13 +3657,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
 		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.satelliteLinkClient.Service)
 		// Add custom header for analytics
 		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initESSchemaRegistry() {
+	authenticator := session.authenticator
+	var err error
 	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
 		Authenticator: authenticator,
 	}
@@ -3122,13 +3676,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
 	}
 	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.esSchemaRegistryClient.Service)
 		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCdToolchain() {
 	// Construct an "options" struct for creating the service client.
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	var cdToolchainClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
@@ -3153,7 +3713,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cdToolchainClient.Service)
 		// Add custom header for analytics
 		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3161,8 +3721,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
 	}
+}
 
+func (session *clientSession) initCdTektonPipeline() {
 	// Construct an "options" struct for creating the tekton pipeline service client.
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	var cdTektonPipelineClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
@@ -3186,7 +3752,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.cdTektonPipelineClient.Service)
 		// Add custom header for analytics
 		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3194,8 +3760,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
 	}
+}
 
+func (session *clientSession) initCodeEngine() {
 	// Construct the service options.
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	codeEngineEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.codeengine", c.Region), cloudEndpoint+"/v2")
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		codeEngineEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.codeengine", c.Region), cloudEndpoint+"/v2")
@@ -3212,7 +3784,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.codeEngineClient, err = codeengine.NewCodeEngineV2(codeEngineClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.codeEngineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.configureService(session.codeEngineClient.Service)
 		// Add custom header for analytics
 		session.codeEngineClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3220,16 +3792,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.codeEngineClientErr = fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
 	}
+}
 
-	if os.Getenv("TF_LOG") != "" {
-		logDestination := log.Writer()
-		goLogger := log.New(logDestination, "", log.LstdFlags)
-		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
+// retryPolicy returns the configured retry policy or the one matching max_retries.
+func (c *Config) retryPolicy() *RetryPolicy {
+	if c.RetryPolicy != nil {
+		return c.RetryPolicy
 	}
-
-	// setting UserAgent for vpc-go-sdk common
-	common.UserAgent = fmt.Sprintf("terraform-provider-ibm/%s", version.Version)
-	return session, nil
+	return NewRetryPolicy(c.RetryCount+1, time.Second, c.RetryDelay, nil)
 }
 
 // CreateVersionDate requires mandatory version attribute. Any date from 2019-12-13 up to the currentdate may be provided. Specify the current date to request the latest version.
@@ -3241,6 +3811,10 @@ func CreateVersionDate() *string {
 func newSession(c *Config) (*Session, error) {
 	ibmSession := &Session{}
 
+	if c.PrivateEndpointsOnly && c.Visibility != "private" {
+		return nil, fmt.Errorf("[ERROR] private_endpoints_only requires visibility to be private, got %q", c.Visibility)
+	}
+
 	softlayerSession := &slsession.Session{
 		Endpoint:  c.SoftLayerEndpointURL,
 		Timeout:   c.SoftLayerTimeout,
@@ -3271,6 +3845,38 @@ func newSession(c *Config) (*Session, error) {
 		return nil, fmt.Errorf("iam_token and iam_profile_id must be provided")
 	}
 
+	transportConfig := DefaultTransportConfig
+	if c.Transport != nil {
+		transportConfig = *c.Transport
+	}
+	// Every session has its own connection pool, so that provider aliases keep
+	// their own proxy and TLS settings.
+	pool, err := NewTransport(transportConfig)
+	if err != nil {
+		return nil, err
+	}
+	if c.AuditLogPath != "" {
+		if ibmSession.auditLogger, err = NewAuditLogger(c.AuditLogPath); err != nil {
+			return nil, err
+		}
+	}
+	var transport gohttp.RoundTripper = &auditTransport{next: pool, logger: ibmSession.auditLogger}
+	if c.PrivateEndpointsOnly {
+		endpointsFile := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile)
+		privateTransport, err := newPrivateEndpointsOnlyTransport(transport, endpointsFile, c.Region)
+		if err != nil {
+			return nil, err
+		}
+		transport = privateTransport
+	}
+	ibmSession.transport = transport
+	softlayerSession.HTTPClient = &gohttp.Client{Transport: transport}
+
+	// bluemix-go only retries timeouts with a constant delay, the retry policy
+	// is applied through its HTTP client instead.
+	retryPolicy := c.retryPolicy()
+	noRetries := 0
+
 	if c.IAMToken != "" {
 		log.Println("Configuring IBM Cloud Session with token")
 		var sess *bxsession.Session
@@ -3283,11 +3889,15 @@ func newSession(c *Config) (*Session, error) {
 			Region:        c.Region,
 			ResourceGroup: c.ResourceGroup,
 			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
+			MaxRetries:    &noRetries,
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		}
+		bmxConfig.HTTPClient = retryPolicy.StandardClient(&gohttp.Client{
+			Transport: http.NewTraceLoggingTransport(transport),
+			Timeout:   c.BluemixTimeout,
+		})
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
@@ -3306,11 +3916,15 @@ func newSession(c *Config) (*Session, error) {
 			Region:        c.Region,
 			ResourceGroup: c.ResourceGroup,
 			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
+			MaxRetries:    &noRetries,
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		}
+		bmxConfig.HTTPClient = retryPolicy.StandardClient(&gohttp.Client{
+			Transport: http.NewTraceLoggingTransport(transport),
+			Timeout:   c.BluemixTimeout,
+		})
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
@@ -3417,28 +4031,8 @@ func EnvFallBack(envs []string, defaultValue string) string {
 	return defaultValue
 }
 
-func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defaultValue string) string {
-	if val, ok := fileMap[key]; ok {
-		if v, ok := val.(map[string]interface{})[visibility]; ok {
-			if r, ok := v.(map[string]interface{})[region]; ok && r.(string) != "" {
-				return r.(string)
-			}
-		}
-	}
-	return defaultValue
-}
-
-// DefaultTransport ...
-func DefaultTransport() gohttp.RoundTripper {
-	transport := &gohttp.Transport{
-		Proxy:               gohttp.ProxyFromEnvironment,
-		DisableKeepAlives:   true,
-		MaxIdleConnsPerHost: -1,
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: false,
-		},
-	}
-	return transport
+func fileFallBack(fileMap EndpointsFile, visibility, key, region, defaultValue string) string {
+	return fileMap.Lookup(visibility, key, region, defaultValue)
 }
 
 func isRetryable(err error) bool {
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/go-sdk-core/v5/core"
+	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func newTestClientSession(t *testing.T, visibility string) *clientSession {
+	bmxSession, err := bxsession.New(&bluemix.Config{Region: "us-south", Visibility: visibility})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &clientSession{
+		session:       &Session{BluemixSession: bmxSession},
+		config:        &Config{Region: "us-south", Visibility: visibility},
+		authenticator: &core.NoAuthAuthenticator{},
+		retryPolicy:   NewRetryPolicy(1, time.Millisecond, time.Millisecond, nil),
+	}
+}
+
+func TestClientSessionBuildsClientsOnFirstUse(t *testing.T) {
+	session := newTestClientSession(t, "public")
+	if session.vpcAPI != nil || session.transitgatewayAPI != nil {
+		t.Fatal("expected no client to be built before first use")
+	}
+
+	var wg sync.WaitGroup
+	clients := make([]*vpc.VpcV1, 10)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			client, err := session.VpcV1API()
+			if err != nil {
+				t.Error(err)
+			}
+			clients[i] = client
+		}(i)
+	}
+	wg.Wait()
+
+	for _, client := range clients {
+		if client == nil || client != clients[0] {
+			t.Fatal("expected every caller to get the same VPC client")
+		}
+	}
+	if session.transitgatewayAPI != nil {
+		t.Fatal("expected the transit gateway client not to be built by the VPC accessor")
+	}
+}
+
+func TestClientSessionIsolatesFailures(t *testing.T) {
+	session := newTestClientSession(t, "private")
+
+	if _, err := session.AppIDAPI(); err == nil {
+		t.Fatal("expected AppID to fail with private endpoints")
+	}
+	if _, err := session.VpcV1API(); err != nil {
+		t.Fatalf("expected the AppID failure not to affect the VPC client: %s", err)
+	}
+}
+
+func TestClientSessionWithoutCredentials(t *testing.T) {
+	session := &clientSession{session: &Session{}}
+
+	if _, err := session.VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected %q, got %v", errEmptyBluemixCredentials, err)
+	}
+	if _, err := session.CisZonesV1ClientSession(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected %q, got %v", errEmptyBluemixCredentials, err)
+	}
+}
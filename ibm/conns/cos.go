@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	gohttp "net/http"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
+	cossession "github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// CosS3API returns an S3 client of the Cloud Object Storage instance for the
+// buckets of a region, used by the services reading the objects they write.
+// The endpoint follows the visibility of the provider, and the requests go
+// through the transport of the session.
+func (session *clientSession) CosS3API(region, instanceCRN string) (*s3.S3, error) {
+	bxSession, err := session.BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	visibility := ""
+	if session.config != nil {
+		visibility = session.config.Visibility
+	}
+	endpoint := EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, cosEndpoint(visibility, region))
+
+	authEndpoint, err := bxSession.Config.EndpointLocator.IAMEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
+
+	httpConf := aws.NewConfig().WithHTTPClient(&gohttp.Client{Transport: session.transport()})
+	s3Conf := httpConf.Copy().WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	if apiKey := bxSession.Config.BluemixAPIKey; apiKey != "" {
+		s3Conf = s3Conf.WithCredentials(ibmiam.NewStaticCredentials(httpConf, authEndpointPath, apiKey, instanceCRN))
+	} else if bxSession.Config.IAMAccessToken != "" {
+		initFunc := func() (*token.Token, error) {
+			return &token.Token{
+				AccessToken:  bxSession.Config.IAMAccessToken,
+				RefreshToken: bxSession.Config.IAMRefreshToken,
+				TokenType:    "Bearer",
+				ExpiresIn:    int64((time.Hour * 248).Seconds()) * -1,
+				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
+			}, nil
+		}
+		s3Conf = s3Conf.WithCredentials(ibmiam.NewCustomInitFuncCredentials(httpConf, initFunc, authEndpointPath, instanceCRN))
+	} else {
+		return nil, fmt.Errorf("[ERROR] Cloud Object Storage requires an API key or an IAM access token")
+	}
+	s3Sess, err := cossession.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	return s3.New(s3Sess, s3Conf), nil
+}
+
+// cosEndpoint returns the Cloud Object Storage endpoint of a region for the
+// visibility of the provider.
+func cosEndpoint(visibility, region string) string {
+	if visibility == "private" || visibility == "public-and-private" {
+		return fmt.Sprintf("s3.private.%s.cloud-object-storage.appdomain.cloud", region)
+	}
+	return fmt.Sprintf("s3.%s.cloud-object-storage.appdomain.cloud", region)
+}
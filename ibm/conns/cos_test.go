@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+)
+
+func TestCosEndpoint(t *testing.T) {
+	cases := map[string]string{
+		"":                   "s3.eu-de.cloud-object-storage.appdomain.cloud",
+		"public":             "s3.eu-de.cloud-object-storage.appdomain.cloud",
+		"private":            "s3.private.eu-de.cloud-object-storage.appdomain.cloud",
+		"public-and-private": "s3.private.eu-de.cloud-object-storage.appdomain.cloud",
+	}
+	for visibility, expected := range cases {
+		if endpoint := cosEndpoint(visibility, "eu-de"); endpoint != expected {
+			t.Errorf("visibility %q: expected %s, got %s", visibility, expected, endpoint)
+		}
+	}
+}
+
+func TestCosS3APIUsesTheSessionTransport(t *testing.T) {
+	t.Setenv("IBMCLOUD_COS_ENDPOINT", "")
+	bmxSession, err := bxsession.New(&bluemix.Config{Region: "us-south", Visibility: "private", BluemixAPIKey: "key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := &gohttp.Transport{}
+	session := &clientSession{
+		session: &Session{BluemixSession: bmxSession, transport: transport},
+		config:  &Config{Region: "us-south", Visibility: "private"},
+	}
+
+	client, err := session.CosS3API("eu-de", "crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678::")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if endpoint := *client.Config.Endpoint; endpoint != "s3.private.eu-de.cloud-object-storage.appdomain.cloud" {
+		t.Errorf("expected the private endpoint of eu-de, got %s", endpoint)
+	}
+	if client.Config.HTTPClient == nil || client.Config.HTTPClient.Transport != transport {
+		t.Error("expected the requests to go through the transport of the session")
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	gohttp "net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+)
+
+// EndpointsFile is the typed content of the file referenced by endpoints_file_path.
+// It maps a service key to the regional endpoints of that service, for example
+//
+//	{
+//	  "IBMCLOUD_IS_NG_API_ENDPOINT": {
+//	    "public":  {"us-south": "https://us-south.iaas.cloud.ibm.com/v1"},
+//	    "private": {"us-south": "https://us-south.private.iaas.cloud.ibm.com/v1"}
+//	  }
+//	}
+type EndpointsFile map[string]ServiceEndpoints
+
+// ServiceEndpoints holds the region to URL mapping of a service for each visibility.
+type ServiceEndpoints struct {
+	Public  map[string]string `json:"public,omitempty"`
+	Private map[string]string `json:"private,omitempty"`
+}
+
+// knownEndpointKeys are the service keys understood by the provider and by the
+// bluemix-go endpoint locator, which reads the same file.
+var knownEndpointKeys = map[string]bool{
+	"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT":     true,
+	"IBMCLOUD_API_GATEWAY_ENDPOINT":                true,
+	"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT":       true,
+	"IBMCLOUD_APP_CONFIG_ENDPOINT":                 true,
+	"IBMCLOUD_ATRACKER_API_ENDPOINT":               true,
+	"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT":     true,
+	"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT":    true,
+	"IBMCLOUD_CIS_API_ENDPOINT":                    true,
+	"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT":            true,
+	"IBMCLOUD_CODE_ENGINE_API_ENDPOINT":            true,
+	"IBMCLOUD_COMPLIANCE_API_ENDPOINT":             true,
+	"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT": true,
+	"IBMCLOUD_COS_CONFIG_ENDPOINT":                 true,
+	"IBMCLOUD_CR_API_ENDPOINT":                     true,
+	"IBMCLOUD_CSE_ENDPOINT":                        true,
+	"IBMCLOUD_CS_API_ENDPOINT":                     true,
+	"IBMCLOUD_DATABASES_API_ENDPOINT":              true,
+	"IBMCLOUD_DL_API_ENDPOINT":                     true,
+	"IBMCLOUD_DL_PROVIDER_API_ENDPOINT":            true,
+	"IBMCLOUD_ENTERPRISE_API_ENDPOINT":             true,
+	"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT":    true,
+	"IBMCLOUD_FUNCTIONS_API_ENDPOINT":              true,
+	"IBMCLOUD_GS_API_ENDPOINT":                     true,
+	"IBMCLOUD_GT_API_ENDPOINT":                     true,
+	"IBMCLOUD_HPCS_API_ENDPOINT":                   true,
+	"IBMCLOUD_HPCS_TKE_ENDPOINT":                   true,
+	"IBMCLOUD_IAMPAP_API_ENDPOINT":                 true,
+	"IBMCLOUD_IAM_API_ENDPOINT":                    true,
+	"IBMCLOUD_ICD_API_ENDPOINT":                    true,
+	"IBMCLOUD_IS_NG_API_ENDPOINT":                  true,
+	"IBMCLOUD_KP_API_ENDPOINT":                     true,
+	"IBMCLOUD_MCCP_API_ENDPOINT":                   true,
+	"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT":        true,
+	"IBMCLOUD_PI_API_ENDPOINT":                     true,
+	"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT":            true,
+	"IBMCLOUD_PROJECT_API_ENDPOINT":                true,
+	"IBMCLOUD_PUSH_API_ENDPOINT":                   true,
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT":       true,
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT":    true,
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT":    true,
+	"IBMCLOUD_SATELLITE_API_ENDPOINT":              true,
+	"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT":         true,
+	"IBMCLOUD_SAT_API_ENDPOINT":                    true,
+	"IBMCLOUD_SCC_API_ENDPOINT":                    true,
+	"IBMCLOUD_SCHEMATICS_API_ENDPOINT":             true,
+	"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT":        true,
+	"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT":            true,
+	"IBMCLOUD_TG_API_ENDPOINT":                     true,
+	"IBMCLOUD_TOOLCHAIN_ENDPOINT":                  true,
+	"IBMCLOUD_UAA_ENDPOINT":                        true,
+	"IBMCLOUD_USER_MANAGEMENT_ENDPOINT":            true,
+}
+
+// envOnlyEndpointKeys are the endpoint environment variables read by the
+// services that do not look their endpoint up in the endpoints file.
+var envOnlyEndpointKeys = []string{
+	"IBMCLOUD_APP_CONFIG_API_ENDPOINT",
+	"IBMCLOUD_CLOUDANT_API_ENDPOINT",
+	"IBMCLOUD_CLOUDANT_ENDPOINT",
+	"IBMCLOUD_COS_ENDPOINT",
+}
+
+// privateEndpointDomains are the domains of the IBM Cloud service endpoints.
+// A host of these domains is private when a label before the domain is
+// private, direct or starts with private-, such as private.iam.cloud.ibm.com,
+// private-us-south.schematics.cloud.ibm.com or
+// s3.direct.us-south.cloud-object-storage.appdomain.cloud.
+var privateEndpointDomains = []string{
+	"cloud.ibm.com",
+	"appdomain.cloud",
+}
+
+// LoadEndpointsFile reads and validates the endpoints file at the given path.
+// When privateOnly is set every service in the file must have a private
+// endpoint for the region.
+func LoadEndpointsFile(path, region string, privateOnly bool) (EndpointsFile, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to read endpoints file %s: %s", path, err)
+	}
+	var file EndpointsFile
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to parse endpoints file %s: %s", path, err)
+	}
+	if err := file.Validate(region, privateOnly); err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid endpoints file %s: %s", path, err)
+	}
+	return file, nil
+}
+
+// Validate reports every unknown service key, empty region and malformed URL in
+// the file, and with privateOnly every service without a private endpoint for the region.
+func (f EndpointsFile) Validate(region string, privateOnly bool) error {
+	var problems []string
+	keys := make([]string, 0, len(f))
+	for key := range f {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !knownEndpointKeys[key] {
+			problems = append(problems, fmt.Sprintf("unknown service key %q", key))
+			continue
+		}
+		endpoints := f[key]
+		problems = append(problems, validateRegionalEndpoints(key, "public", endpoints.Public)...)
+		problems = append(problems, validateRegionalEndpoints(key, "private", endpoints.Private)...)
+		if privateOnly && endpoints.Private[region] == "" {
+			problems = append(problems, fmt.Sprintf("%s has no private endpoint for region %q", key, region))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// Lookup returns the endpoint of the service key for the visibility and region,
+// or defaultValue when the file does not define one.
+func (f EndpointsFile) Lookup(visibility, key, region, defaultValue string) string {
+	endpoints, ok := f[key]
+	if !ok {
+		return defaultValue
+	}
+	var regional map[string]string
+	switch visibility {
+	case "public":
+		regional = endpoints.Public
+	case "private":
+		regional = endpoints.Private
+	}
+	if r, ok := regional[region]; ok && r != "" {
+		return r
+	}
+	return defaultValue
+}
+
+func validateRegionalEndpoints(key, visibility string, regional map[string]string) []string {
+	var problems []string
+	regions := make([]string, 0, len(regional))
+	for region := range regional {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	for _, region := range regions {
+		if strings.TrimSpace(region) == "" {
+			problems = append(problems, fmt.Sprintf("%s.%s has an empty region name", key, visibility))
+			continue
+		}
+		endpoint := regional[region]
+		u, err := url.Parse(endpoint)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			problems = append(problems, fmt.Sprintf("%s.%s.%s has a malformed URL %q", key, visibility, region, endpoint))
+		}
+	}
+	return problems
+}
+
+// privateEndpointsOnlyTransport refuses the requests to public endpoints when
+// private_endpoints_only is set. It covers every client of the session, so a
+// service whose endpoint is resolved in code and falls back to its public URL
+// fails instead of silently leaving the private network.
+type privateEndpointsOnlyTransport struct {
+	next gohttp.RoundTripper
+	// allowed are the hosts set explicitly in the private section of the
+	// endpoints file or in an endpoint environment variable
+	allowed map[string]bool
+}
+
+// newPrivateEndpointsOnlyTransport wraps next, allowing the private endpoints
+// of the endpoints file at path and the endpoints set in the environment.
+func newPrivateEndpointsOnlyTransport(next gohttp.RoundTripper, path, region string) (*privateEndpointsOnlyTransport, error) {
+	t := &privateEndpointsOnlyTransport{next: next, allowed: map[string]bool{}}
+	if path != "" {
+		file, err := LoadEndpointsFile(path, region, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, endpoints := range file {
+			for _, endpoint := range endpoints.Private {
+				t.allow(endpoint)
+			}
+		}
+	}
+	for key := range knownEndpointKeys {
+		if endpoint := os.Getenv(key); endpoint != "" {
+			t.allow(endpoint)
+		}
+	}
+	for _, key := range envOnlyEndpointKeys {
+		if endpoint := os.Getenv(key); endpoint != "" {
+			t.allow(endpoint)
+		}
+	}
+	return t, nil
+}
+
+func (t *privateEndpointsOnlyTransport) allow(endpoint string) {
+	if u, err := url.Parse(endpoint); err == nil && u.Hostname() != "" {
+		t.allowed[strings.ToLower(u.Hostname())] = true
+	}
+}
+
+func (t *privateEndpointsOnlyTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	host := strings.ToLower(req.URL.Hostname())
+	if !t.allowed[host] && !IsPrivateEndpointHost(host) {
+		return nil, fmt.Errorf("[ERROR] private_endpoints_only is set and %s is not a private endpoint, "+
+			"set the private endpoint of the service in the endpoints file or in its IBMCLOUD_*_ENDPOINT environment variable", host)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// IsPrivateEndpointHost reports whether host is an IBM Cloud private endpoint, such as
+// private.iam.cloud.ibm.com, us-south.private.iaas.cloud.ibm.com or
+// s3.direct.us-south.cloud-object-storage.appdomain.cloud, or is a private address.
+func IsPrivateEndpointHost(host string) bool {
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.IsPrivate() || ip.IsLoopback()
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	for _, domain := range privateEndpointDomains {
+		if !strings.HasSuffix(host, "."+domain) {
+			continue
+		}
+		for _, label := range strings.Split(strings.TrimSuffix(host, "."+domain), ".") {
+			if label == "private" || label == "direct" || strings.HasPrefix(label, "private-") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"io/ioutil"
+	gohttp "net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+)
+
+func writeEndpointsFile(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "endpoints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "endpoints.json")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadEndpointsFile(t *testing.T) {
+	path := writeEndpointsFile(t, `{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {
+			"public": {"us-south": "https://us-south.iaas.cloud.ibm.com/v1"},
+			"private": {"us-south": "https://us-south.private.iaas.cloud.ibm.com/v1"}
+		}
+	}`)
+
+	file, err := LoadEndpointsFile(path, "us-south", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := file.Lookup("private", "IBMCLOUD_IS_NG_API_ENDPOINT", "us-south", "default"); got != "https://us-south.private.iaas.cloud.ibm.com/v1" {
+		t.Fatalf("unexpected private endpoint %q", got)
+	}
+	if got := file.Lookup("private", "IBMCLOUD_IS_NG_API_ENDPOINT", "eu-de", "default"); got != "default" {
+		t.Fatalf("expected default for a missing region, got %q", got)
+	}
+	if got := file.Lookup("private", "IBMCLOUD_TG_API_ENDPOINT", "us-south", "default"); got != "default" {
+		t.Fatalf("expected default for a missing service, got %q", got)
+	}
+}
+
+func TestLoadEndpointsFileErrors(t *testing.T) {
+	cases := map[string]struct {
+		content     string
+		privateOnly bool
+		want        string
+	}{
+		"unknown service key": {
+			content: `{"IBMCLOUD_IS_NG_API_ENDPIONT": {"public": {"us-south": "https://us-south.iaas.cloud.ibm.com/v1"}}}`,
+			want:    `unknown service key "IBMCLOUD_IS_NG_API_ENDPIONT"`,
+		},
+		"unknown visibility": {
+			content: `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"privte": {"us-south": "https://us-south.iaas.cloud.ibm.com/v1"}}}`,
+			want:    `unknown field "privte"`,
+		},
+		"malformed url": {
+			content: `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": {"us-south": "us-south.iaas.cloud.ibm.com/v1"}}}`,
+			want:    "IBMCLOUD_IS_NG_API_ENDPOINT.public.us-south has a malformed URL",
+		},
+		"missing private endpoint": {
+			content:     `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": {"us-south": "https://us-south.iaas.cloud.ibm.com/v1"}}}`,
+			privateOnly: true,
+			want:        `IBMCLOUD_IS_NG_API_ENDPOINT has no private endpoint for region "us-south"`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadEndpointsFile(writeEndpointsFile(t, tc.content), "us-south", tc.privateOnly)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("expected error to contain %q, got %q", tc.want, err)
+			}
+		})
+	}
+}
+
+type recordingTransport struct {
+	hosts []string
+}
+
+func (t *recordingTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	t.hosts = append(t.hosts, req.URL.Hostname())
+	return &gohttp.Response{StatusCode: 200, Body: gohttp.NoBody, Header: gohttp.Header{}, Request: req}, nil
+}
+
+func TestPrivateEndpointsOnlyRejectsServicesResolvedInCode(t *testing.T) {
+	next := &recordingTransport{}
+	transport, err := newPrivateEndpointsOnlyTransport(next, "", "xx-north")
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := newTestClientSession(t, "private")
+	session.config.Region = "xx-north"
+	session.config.PrivateEndpointsOnly = true
+	session.session.transport = transport
+
+	// Activity Tracker has no endpoint for the region and falls back to its public default URL
+	client, err := session.AtrackerV2()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.Service.GetServiceURL() != atrackerv2.DefaultServiceURL {
+		t.Fatalf("expected the public default URL, got %s", client.Service.GetServiceURL())
+	}
+	_, _, err = client.GetSettings(&atrackerv2.GetSettingsOptions{})
+	if err == nil || !strings.Contains(err.Error(), "us-south.atracker.cloud.ibm.com is not a private endpoint") {
+		t.Fatalf("expected the public endpoint to be refused, got %v", err)
+	}
+	if len(next.hosts) != 0 {
+		t.Fatalf("expected no request to leave the transport, got %v", next.hosts)
+	}
+}
+
+func TestPrivateEndpointsOnlyTransport(t *testing.T) {
+	path := writeEndpointsFile(t, `{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {
+			"private": {"us-south": "https://vpc.example.internal/v1"}
+		}
+	}`)
+	t.Setenv("IBMCLOUD_TG_API_ENDPOINT", "https://tg.example.internal/v1")
+	t.Setenv("IBMCLOUD_COS_ENDPOINT", "https://cos.example.internal")
+	next := &recordingTransport{}
+	transport, err := newPrivateEndpointsOnlyTransport(next, path, "us-south")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string]bool{
+		"https://private.iam.cloud.ibm.com/identity/token":                true,
+		"https://us-south.private.iaas.cloud.ibm.com/v1":                  true,
+		"https://private-us-south.schematics.cloud.ibm.com/v1":            true,
+		"https://s3.direct.us-south.cloud-object-storage.appdomain.cloud": true,
+		"https://10.0.0.12/v1":                                            true,
+		"https://vpc.example.internal/v1":                                 true,
+		"https://tg.example.internal/v1":                                  true,
+		"https://us-south.iaas.cloud.ibm.com/v1":                          false,
+		"https://iam.cloud.ibm.com/identity/token":                        false,
+		"https://cos.example.internal/bucket/object":                      true,
+		"https://privatelink.example.com/v1":                              false,
+		"https://private.example.com/v1":                                  false,
+		"https://api.direct.example.net/v1":                               false,
+		"https://private.iam.cloud.ibm.com.example.com/identity/token":    false,
+		"https://s3.us-south.cloud-object-storage.appdomain.cloud":        false,
+	}
+	for endpoint, allowed := range cases {
+		req, err := gohttp.NewRequest("GET", endpoint, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = transport.RoundTrip(req)
+		if allowed && err != nil {
+			t.Errorf("expected %s to be allowed, got %s", endpoint, err)
+		}
+		if !allowed && err == nil {
+			t.Errorf("expected %s to be refused", endpoint)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DefaultLockTimeout is the longest time to wait for a lock when the provider
+// configuration has no lock_timeout.
+const DefaultLockTimeout = 20 * time.Minute
+
+// Waits for a lock at least this long are logged with the stats of the manager
+const longLockWait = time.Minute
+
+// IbmLockManager serializes the changes of the resources sharing a parent,
+// like the rules of a security group, within this plugin.
+var IbmLockManager = NewLockManager(DefaultLockTimeout)
+
+// LockManager is a store of read/write locks by key. Waiting for a lock stops
+// when the context is done or after the lock_timeout of the provider
+// configuration, and the locks nobody holds or waits for are evicted. The locks
+// are shared by every provider alias of this plugin, their timeouts are not.
+type LockManager struct {
+	mu      sync.Mutex
+	locks   map[string]*keyLock
+	timeout time.Duration
+	stats   LockStats
+}
+
+// LockStats are the debug metrics of a LockManager.
+type LockStats struct {
+	// Number of locks acquired, and of waits stopped by the context or the timeout
+	Acquired int64
+	Failed   int64
+	// Time spent waiting for the locks acquired
+	TotalWait time.Duration
+	MaxWait   time.Duration
+}
+
+type keyLock struct {
+	writer  bool
+	readers int
+	// Waiters are granted the lock in order, so that readers don't starve writers
+	waiters []*lockWaiter
+	// Holders and waiters, the lock is evicted when there are none
+	refs int
+}
+
+type lockWaiter struct {
+	write bool
+	ready chan struct{}
+}
+
+// NewLockManager returns a LockManager waiting at most timeout for a lock when
+// the provider configuration has no lock_timeout, or as long as the context
+// allows when timeout is zero.
+func NewLockManager(timeout time.Duration) *LockManager {
+	return &LockManager{
+		locks:   make(map[string]*keyLock),
+		timeout: timeout,
+	}
+}
+
+// Lock acquires the lock of the key for writing, exclusively of any other
+// holder, waiting at most the lock_timeout of the provider configuration meta.
+// The caller must call the returned function to release it.
+func (m *LockManager) Lock(ctx context.Context, meta interface{}, key string) (func(), error) {
+	return m.acquire(ctx, m.lockTimeout(meta), key, true)
+}
+
+// RLock acquires the lock of the key for reading, shared with other readers,
+// waiting at most the lock_timeout of the provider configuration meta. The
+// caller must call the returned function to release it.
+func (m *LockManager) RLock(ctx context.Context, meta interface{}, key string) (func(), error) {
+	return m.acquire(ctx, m.lockTimeout(meta), key, false)
+}
+
+// LockOperation acquires the lock of the key for writing in a CRUD function
+// without a context, waiting at most the timeout of the operation, like
+// schema.TimeoutCreate, and the lock_timeout of the provider configuration meta.
+func (m *LockManager) LockOperation(d *schema.ResourceData, meta interface{}, operation, key string) (func(), error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(operation))
+	defer cancel()
+	return m.acquire(ctx, m.lockTimeout(meta), key, true)
+}
+
+// RLockOperation acquires the lock of the key for reading in a CRUD function
+// without a context, waiting at most the timeout of the operation and the
+// lock_timeout of the provider configuration meta.
+func (m *LockManager) RLockOperation(d *schema.ResourceData, meta interface{}, operation, key string) (func(), error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(operation))
+	defer cancel()
+	return m.acquire(ctx, m.lockTimeout(meta), key, false)
+}
+
+// lockTimeout returns the lock_timeout of the provider configuration, or the
+// timeout of the manager when meta has none.
+func (m *LockManager) lockTimeout(meta interface{}) time.Duration {
+	if session, ok := meta.(interface{ LockTimeout() time.Duration }); ok {
+		return session.LockTimeout()
+	}
+	return m.timeout
+}
+
+// Stats returns the debug metrics of the manager.
+func (m *LockManager) Stats() LockStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.stats
+}
+
+func (m *LockManager) acquire(ctx context.Context, timeout time.Duration, key string, write bool) (func(), error) {
+	start := time.Now()
+	log.Printf("[DEBUG] Locking %q", key)
+
+	m.mu.Lock()
+	l, ok := m.locks[key]
+	if !ok {
+		l = &keyLock{}
+		m.locks[key] = l
+	}
+	l.refs++
+	if len(l.waiters) == 0 && l.available(write) {
+		l.grant(write)
+		m.acquiredLocked(key, start)
+		m.mu.Unlock()
+		return m.releaseFunc(key, l, write), nil
+	}
+	w := &lockWaiter{write: write, ready: make(chan struct{})}
+	l.waiters = append(l.waiters, w)
+	m.mu.Unlock()
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	select {
+	case <-w.ready:
+		m.mu.Lock()
+		m.acquiredLocked(key, start)
+		m.mu.Unlock()
+		return m.releaseFunc(key, l, write), nil
+	case <-ctx.Done():
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	select {
+	case <-w.ready:
+		// The lock was granted while giving up
+		m.releaseLocked(key, l, write)
+	default:
+		l.remove(w)
+		l.refs--
+		m.promoteLocked(key, l)
+	}
+	m.stats.Failed++
+	wait := time.Since(start).Round(time.Millisecond)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("[ERROR] Timed out after %s waiting for the lock on %q", wait, key)
+	}
+	return nil, fmt.Errorf("[ERROR] Stopped waiting for the lock on %q after %s: %w", key, wait, ctx.Err())
+}
+
+func (m *LockManager) acquiredLocked(key string, start time.Time) {
+	wait := time.Since(start)
+	m.stats.Acquired++
+	m.stats.TotalWait += wait
+	if wait > m.stats.MaxWait {
+		m.stats.MaxWait = wait
+	}
+	log.Printf("[DEBUG] Locked %q after waiting %s", key, wait.Round(time.Millisecond))
+	if wait >= longLockWait {
+		log.Printf("[DEBUG] Lock stats: %d acquired, %d failed, %s waited in total, %s at most",
+			m.stats.Acquired, m.stats.Failed, m.stats.TotalWait.Round(time.Millisecond), m.stats.MaxWait.Round(time.Millisecond))
+	}
+}
+
+func (m *LockManager) releaseFunc(key string, l *keyLock, write bool) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			log.Printf("[DEBUG] Unlocking %q", key)
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			m.releaseLocked(key, l, write)
+		})
+	}
+}
+
+func (m *LockManager) releaseLocked(key string, l *keyLock, write bool) {
+	if write {
+		l.writer = false
+	} else {
+		l.readers--
+	}
+	l.refs--
+	m.promoteLocked(key, l)
+}
+
+// promoteLocked grants the lock to the waiters at the head of the queue, and
+// evicts it when it is idle.
+func (m *LockManager) promoteLocked(key string, l *keyLock) {
+	for len(l.waiters) > 0 && l.available(l.waiters[0].write) {
+		w := l.waiters[0]
+		l.waiters = l.waiters[1:]
+		l.grant(w.write)
+		close(w.ready)
+	}
+	if l.refs == 0 {
+		delete(m.locks, key)
+	}
+}
+
+func (l *keyLock) available(write bool) bool {
+	if write {
+		return !l.writer && l.readers == 0
+	}
+	return !l.writer
+}
+
+func (l *keyLock) grant(write bool) {
+	if write {
+		l.writer = true
+	} else {
+		l.readers++
+	}
+}
+
+func (l *keyLock) remove(w *lockWaiter) {
+	for i, waiter := range l.waiters {
+		if waiter == w {
+			l.waiters = append(l.waiters[:i], l.waiters[i+1:]...)
+			return
+		}
+	}
+}
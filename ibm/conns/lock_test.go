@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestLockManagerLock(t *testing.T) {
+	m := NewLockManager(0)
+	unlock, err := m.Lock(context.Background(), nil, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doneCh := make(chan struct{})
+	go func() {
+		unlock, err := m.Lock(context.Background(), nil, "foo")
+		if err == nil {
+			unlock()
+		}
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		t.Fatal("Second lock was able to be taken. This shouldn't happen.")
+	case <-time.After(50 * time.Millisecond):
+		// pass
+	}
+
+	unlock()
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(time.Second):
+		t.Fatal("Second lock was not taken after the first was released")
+	}
+}
+
+func TestLockManagerDifferentKeys(t *testing.T) {
+	m := NewLockManager(0)
+	if _, err := m.Lock(context.Background(), nil, "foo"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := m.Lock(ctx, nil, "bar"); err != nil {
+		t.Fatalf("expected the lock of another key to be free: %s", err)
+	}
+}
+
+func TestLockManagerContextCancel(t *testing.T) {
+	m := NewLockManager(0)
+	unlock, _ := m.Lock(context.Background(), nil, "foo")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error)
+	go func() {
+		_, err := m.Lock(ctx, nil, "foo")
+		errCh <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	if err := <-errCh; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the wait to be cancelled, got %v", err)
+	}
+
+	// The cancelled waiter must not hold the lock once it is released
+	unlock()
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := m.Lock(ctx, nil, "foo"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLockManagerTimeout(t *testing.T) {
+	m := NewLockManager(30 * time.Millisecond)
+	m.Lock(context.Background(), nil, "foo")
+
+	_, err := m.Lock(context.Background(), nil, "foo")
+	if err == nil || !strings.Contains(err.Error(), "Timed out") {
+		t.Fatalf("expected the wait to time out, got %v", err)
+	}
+	if stats := m.Stats(); stats.Acquired != 1 || stats.Failed != 1 {
+		t.Fatalf("expected 1 acquired and 1 failed lock, got %+v", stats)
+	}
+}
+
+func TestLockManagerSessionTimeout(t *testing.T) {
+	m := NewLockManager(0)
+	unlock, _ := m.Lock(context.Background(), nil, "foo")
+	defer unlock()
+
+	// Every provider alias waits as long as its own lock_timeout
+	short := &clientSession{config: &Config{LockTimeout: 30 * time.Millisecond}}
+	long := &clientSession{config: &Config{LockTimeout: time.Hour}}
+	if _, err := m.Lock(context.Background(), short, "foo"); err == nil || !strings.Contains(err.Error(), "Timed out") {
+		t.Fatalf("expected the wait to stop at the lock_timeout of the session, got %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := m.Lock(ctx, long, "foo"); err == nil {
+		t.Fatal("expected the wait to stop with the context")
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("expected the lock_timeout of another session to be left untouched, gave up after %s", elapsed)
+	}
+}
+
+func TestLockManagerLockOperation(t *testing.T) {
+	m := NewLockManager(DefaultLockTimeout)
+	unlock, _ := m.Lock(context.Background(), nil, "foo")
+	defer unlock()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Millisecond),
+		},
+	}
+	d := r.Data(&terraform.InstanceState{})
+	start := time.Now()
+	_, err := m.LockOperation(d, nil, schema.TimeoutCreate, "foo")
+	if err == nil || !strings.Contains(err.Error(), "Timed out") {
+		t.Fatalf("expected the wait to stop at the create timeout, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected the wait to stop after 50ms, it took %s", elapsed)
+	}
+	if _, err := m.RLockOperation(d, nil, schema.TimeoutCreate, "foo"); err == nil {
+		t.Fatal("expected a reader to wait for the writer")
+	}
+}
+
+func TestLockManagerReaders(t *testing.T) {
+	m := NewLockManager(50 * time.Millisecond)
+	unlockRead, err := m.RLock(context.Background(), nil, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.RLock(context.Background(), nil, "foo"); err != nil {
+		t.Fatalf("expected readers to share the lock: %s", err)
+	}
+	if _, err := m.Lock(context.Background(), nil, "foo"); err == nil {
+		t.Fatal("expected a writer to wait for the readers")
+	}
+
+	m = NewLockManager(0)
+	unlockRead, _ = m.RLock(context.Background(), nil, "foo")
+	writerCh := make(chan func())
+	go func() {
+		unlock, _ := m.Lock(context.Background(), nil, "foo")
+		writerCh <- unlock
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	// A waiting writer goes before the readers arriving after it
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	if _, err := m.RLock(ctx, nil, "foo"); err == nil {
+		t.Fatal("expected a reader to wait for the writer ahead of it")
+	}
+	unlockRead()
+	unlockWrite := <-writerCh
+	unlockWrite()
+}
+
+func TestLockManagerEviction(t *testing.T) {
+	m := NewLockManager(0)
+	unlock, _ := m.Lock(context.Background(), nil, "foo")
+	unlockRead, _ := m.RLock(context.Background(), nil, "bar")
+	if len(m.locks) != 2 {
+		t.Fatalf("expected 2 locks, got %d", len(m.locks))
+	}
+	unlock()
+	unlock()
+	unlockRead()
+	if len(m.locks) != 0 {
+		t.Fatalf("expected the idle locks to be evicted, got %d", len(m.locks))
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net"
+	gohttp "net/http"
+	"strconv"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// DefaultRetryableStatusCodes are retried when the retry block does not list any.
+var DefaultRetryableStatusCodes = []int{408, 429, 500, 502, 503, 504, 520, 599}
+
+// RetryPolicy is the provider wide retry configuration applied to every
+// go-sdk-core service client and to the bluemix-go session.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first request
+	MaxAttempts int
+	// MinDelay is the base of the exponential backoff
+	MinDelay time.Duration
+	// MaxDelay caps the computed backoff, a Retry-After header takes precedence
+	MaxDelay time.Duration
+	// RetryableStatusCodes are the HTTP status codes that are retried
+	RetryableStatusCodes []int
+}
+
+// NewRetryPolicy returns a RetryPolicy with the defaults filled in for every unset field.
+func NewRetryPolicy(maxAttempts int, minDelay, maxDelay time.Duration, statusCodes []int) *RetryPolicy {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	if minDelay <= 0 {
+		minDelay = time.Second
+	}
+	if maxDelay < minDelay {
+		maxDelay = minDelay
+	}
+	if len(statusCodes) == 0 {
+		statusCodes = DefaultRetryableStatusCodes
+	}
+	return &RetryPolicy{
+		MaxAttempts:          maxAttempts,
+		MinDelay:             minDelay,
+		MaxDelay:             maxDelay,
+		RetryableStatusCodes: statusCodes,
+	}
+}
+
+// IsRetryableStatus reports whether the status code is one of the retryable codes.
+func (p *RetryPolicy) IsRetryableStatus(statusCode int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckRetry implements retryablehttp.CheckRetry. Transport errors are handled like
+// the go-sdk-core default policy, responses are retried on the configured status codes.
+func (p *RetryPolicy) CheckRetry(ctx context.Context, resp *gohttp.Response, err error) (bool, error) {
+	if err != nil || ctx.Err() != nil {
+		return core.IBMCloudSDKRetryPolicy(ctx, resp, err)
+	}
+	return p.IsRetryableStatus(resp.StatusCode), nil
+}
+
+// Backoff implements retryablehttp.Backoff. It honors the Retry-After header of the
+// response and otherwise waits an exponential delay with jitter, capped at max.
+func (p *RetryPolicy) Backoff(min, max time.Duration, attemptNum int, resp *gohttp.Response) time.Duration {
+	if wait, ok := retryAfter(resp); ok {
+		return wait
+	}
+	return p.Delay(attemptNum)
+}
+
+// Delay returns the wait before the retry that follows attempt attemptNum, counted from zero.
+func (p *RetryPolicy) Delay(attemptNum int) time.Duration {
+	backoff := float64(p.MinDelay) * math.Pow(2, float64(attemptNum))
+	if backoff > float64(p.MaxDelay) || math.IsInf(backoff, 0) {
+		backoff = float64(p.MaxDelay)
+	}
+	// Equal jitter: keep half of the backoff and randomize the other half
+	half := time.Duration(backoff / 2)
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// NewClient returns a retryable client following the policy that sends the
+// individual attempts through httpClient.
+func (p *RetryPolicy) NewClient(httpClient *gohttp.Client) *retryablehttp.Client {
+	client := core.NewRetryableClientWithHTTPClient(httpClient)
+	client.RetryMax = p.MaxAttempts - 1
+	client.RetryWaitMin = p.MinDelay
+	client.RetryWaitMax = p.MaxDelay
+	client.CheckRetry = p.CheckRetry
+	client.Backoff = p.Backoff
+	return client
+}
+
+// StandardClient returns an http.Client retrying its requests following the
+// policy, each call is written once to the audit log with its retry count.
+func (p *RetryPolicy) StandardClient(httpClient *gohttp.Client) *gohttp.Client {
+	return &gohttp.Client{
+		Transport: &auditCallTransport{
+			next: &retryablehttp.RoundTripper{Client: p.NewClient(httpClient)},
+		},
+	}
+}
+
+// Apply configures the go-sdk-core service to retry its requests following the policy.
+func (p *RetryPolicy) Apply(service *core.BaseService) {
+	if service == nil {
+		return
+	}
+	service.Client = p.StandardClient(service.GetHTTPClient())
+}
+
+// IsRetryable reports whether an error returned by a bluemix-go call should be retried.
+func (p *RetryPolicy) IsRetryable(err error) bool {
+	if bmErr, ok := err.(bmxerror.RequestFailure); ok {
+		return p.IsRetryableStatus(bmErr.StatusCode())
+	}
+
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return true
+	}
+
+	return false
+}
+
+// retryAfter parses the Retry-After header of a 429 or 503 response.
+func retryAfter(resp *gohttp.Response) (time.Duration, bool) {
+	if resp == nil || (resp.StatusCode != gohttp.StatusTooManyRequests && resp.StatusCode != gohttp.StatusServiceUnavailable) {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if retryTime, err := gohttp.ParseTime(value); err == nil {
+		wait := time.Until(retryTime)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
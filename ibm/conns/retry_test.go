@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := NewRetryPolicy(5, 100*time.Millisecond, time.Second, nil)
+
+	for attempt := 0; attempt < 10; attempt++ {
+		delay := policy.Delay(attempt)
+		if delay > time.Second {
+			t.Fatalf("attempt %d: delay %s exceeds the max delay", attempt, delay)
+		}
+		if delay < 50*time.Millisecond {
+			t.Fatalf("attempt %d: delay %s is below half of the min delay", attempt, delay)
+		}
+	}
+}
+
+func TestRetryPolicyRetryAfter(t *testing.T) {
+	policy := NewRetryPolicy(5, 100*time.Millisecond, time.Second, nil)
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "7")
+
+	if wait := policy.Backoff(0, 0, 0, resp); wait != 7*time.Second {
+		t.Fatalf("expected the Retry-After header to be honored, got %s", wait)
+	}
+}
+
+func TestRetryPolicyApply(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	NewRetryPolicy(3, time.Millisecond, 10*time.Millisecond, nil).Apply(service)
+
+	builder := core.NewRequestBuilder(core.GET)
+	if _, err := builder.ResolveRequestURL(server.URL, "/", nil); err != nil {
+		t.Fatal(err)
+	}
+	req, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result map[string]interface{}
+	if _, err := service.Request(req, &result); err != nil {
+		t.Fatalf("expected the request to succeed after retries: %s", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestRetryPolicyStatusCodes(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := NewRetryPolicy(4, time.Millisecond, time.Millisecond, []int{429}).NewClient(nil)
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if calls != 1 {
+		t.Fatalf("expected a 502 not to be retried when only 429 is retryable, got %d attempts", calls)
+	}
+}
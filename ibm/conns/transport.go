@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	gohttp "net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// TransportConfig holds the connection pool, proxy and TLS settings of the
+// HTTP transport shared by every client of a provider configuration.
+type TransportConfig struct {
+	// MaxIdleConns limits the idle connections kept across all hosts
+	MaxIdleConns int
+	// MaxIdleConnsPerHost limits the idle connections kept for each host
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost limits the connections to each host, zero means no limit
+	MaxConnsPerHost int
+	// IdleConnTimeout closes connections that stayed idle for longer
+	IdleConnTimeout time.Duration
+
+	// ProxyURL overrides the HTTPS_PROXY and HTTP_PROXY environment variables
+	ProxyURL string
+	// CABundleFile is a PEM file with the certificate authorities trusted in
+	// addition to the system pool
+	CABundleFile string
+	// ClientCertFile and ClientKeyFile are a PEM certificate and key presented
+	// to servers asking for a client certificate
+	ClientCertFile string
+	ClientKeyFile  string
+}
+
+// DefaultTransportConfig is used when the provider block has no transport block.
+var DefaultTransportConfig = TransportConfig{
+	MaxIdleConns:        100,
+	MaxIdleConnsPerHost: 10,
+	IdleConnTimeout:     90 * time.Second,
+}
+
+var (
+	defaultTransportOnce sync.Once
+	defaultTransport     *gohttp.Transport
+)
+
+// NewTransport builds a keep-alive HTTP transport from the configuration.
+func NewTransport(config TransportConfig) (*gohttp.Transport, error) {
+	proxy := gohttp.ProxyFromEnvironment
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("[ERROR] Invalid proxy URL %q", config.ProxyURL)
+		}
+		proxy = gohttp.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if config.CABundleFile != "" {
+		pem, err := ioutil.ReadFile(config.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Unable to read CA bundle %s: %s", config.CABundleFile, err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("[ERROR] No certificate found in CA bundle %s", config.CABundleFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.ClientCertFile != "" || config.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.ClientCertFile, config.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Unable to load client certificate %s: %s", config.ClientCertFile, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return &gohttp.Transport{
+		Proxy: proxy,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          config.MaxIdleConns,
+		MaxIdleConnsPerHost:   config.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       config.MaxConnsPerHost,
+		IdleConnTimeout:       config.IdleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig:       tlsConfig,
+	}, nil
+}
+
+// DefaultTransport returns the keep-alive transport of the clients that are not
+// built from a session. The clients of a session use the transport built from
+// the configuration of its provider block instead, with its audit log.
+func DefaultTransport() gohttp.RoundTripper {
+	defaultTransportOnce.Do(func() {
+		// The default configuration has no files to read and cannot fail
+		defaultTransport, _ = NewTransport(DefaultTransportConfig)
+	})
+	return defaultTransport
+}
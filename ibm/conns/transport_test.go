@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// newCountingTLSServer starts a local TLS stand-in for an IBM Cloud API and
+// counts the connections opened against it.
+func newCountingTLSServer(tb testing.TB) (*httptest.Server, *int32) {
+	var conns int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	server.Config.ConnState = func(c net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&conns, 1)
+		}
+	}
+	server.StartTLS()
+	tb.Cleanup(server.Close)
+	return server, &conns
+}
+
+// trustServer makes the transport trust the self-signed certificate of the stand-in.
+func trustServer(transport *http.Transport, server *httptest.Server) {
+	transport.TLSClientConfig.RootCAs = server.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs
+}
+
+// legacyTransport is the transport every client used before the shared pool.
+func legacyTransport(server *httptest.Server) *http.Transport {
+	return &http.Transport{
+		DisableKeepAlives:   true,
+		MaxIdleConnsPerHost: -1,
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    server.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs,
+		},
+	}
+}
+
+func get(tb testing.TB, client *http.Client, url string) {
+	resp, err := client.Get(url)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+func TestSharedTransportReusesConnections(t *testing.T) {
+	server, conns := newCountingTLSServer(t)
+	transport, err := NewTransport(DefaultTransportConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trustServer(transport, server)
+	client := &http.Client{Transport: transport}
+
+	for i := 0; i < 20; i++ {
+		get(t, client, server.URL)
+	}
+	if *conns != 1 {
+		t.Fatalf("expected the shared transport to reuse a single connection, got %d", *conns)
+	}
+}
+
+func TestNewTransportErrors(t *testing.T) {
+	if _, err := NewTransport(TransportConfig{ProxyURL: "not a proxy"}); err == nil {
+		t.Fatal("expected an error for a malformed proxy URL")
+	}
+	if _, err := NewTransport(TransportConfig{CABundleFile: "/does/not/exist.pem"}); err == nil {
+		t.Fatal("expected an error for a missing CA bundle")
+	}
+	if _, err := NewTransport(TransportConfig{ClientCertFile: "/does/not/exist.pem"}); err == nil {
+		t.Fatal("expected an error for a missing client certificate")
+	}
+}
+
+func TestNewSessionTransport(t *testing.T) {
+	sessionTransport := func(config *TransportConfig) *http.Transport {
+		sess, err := newSession(&Config{Transport: config})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sess.transport.(*auditTransport).next.(*http.Transport)
+	}
+	proxied := sessionTransport(&TransportConfig{MaxIdleConnsPerHost: 5, ProxyURL: "http://proxy.example.com:3128"})
+	direct := sessionTransport(nil)
+	if proxied == direct {
+		t.Fatal("expected every session to have its own transport")
+	}
+	if proxied.MaxIdleConnsPerHost != 5 || proxied.DisableKeepAlives {
+		t.Fatalf("unexpected transport settings: %+v", proxied)
+	}
+	req, _ := http.NewRequest("GET", "https://iam.cloud.ibm.com", nil)
+	if proxyURL, _ := proxied.Proxy(req); proxyURL == nil || proxyURL.Host != "proxy.example.com:3128" {
+		t.Fatalf("expected the configured proxy, got %v", proxyURL)
+	}
+	if direct.MaxIdleConnsPerHost != DefaultTransportConfig.MaxIdleConnsPerHost {
+		t.Fatalf("expected the proxy settings of another session to be left untouched, got %+v", direct)
+	}
+	if DefaultTransport() == proxied {
+		t.Fatal("expected the default transport to be left untouched")
+	}
+}
+
+// Compare with: go test ./ibm/conns -run none -bench Transport
+func BenchmarkLegacyTransport(b *testing.B) {
+	server, conns := newCountingTLSServer(b)
+	client := &http.Client{Transport: legacyTransport(server)}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		get(b, client, server.URL)
+	}
+	b.ReportMetric(float64(*conns)/float64(b.N), "conns/op")
+}
+
+func BenchmarkSharedTransport(b *testing.B) {
+	server, conns := newCountingTLSServer(b)
+	transport, err := NewTransport(DefaultTransportConfig)
+	if err != nil {
+		b.Fatal(err)
+	}
+	trustServer(transport, server)
+	client := &http.Client{Transport: transport}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		get(b, client, server.URL)
+	}
+	b.ReportMetric(float64(*conns)/float64(b.N), "conns/op")
+}
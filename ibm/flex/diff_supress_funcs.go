@@ -13,6 +13,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"golang.org/x/crypto/sha3"
 
@@ -141,3 +142,18 @@ func SuppressAllowBlank(k, old, new string, d *schema.ResourceData) bool {
 	}
 	return false
 }
+
+// SuppressEquivalentTime suppresses the diff between two RFC 3339 date and
+// times naming the same instant, such as 2023-09-28T15:10:00Z and
+// 2023-09-28T15:10:00.000Z.
+func SuppressEquivalentTime(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ErrorClass tells what kind of failure an API error is, independently of the service.
+type ErrorClass string
+
+const (
+	ErrorClassNotFound  ErrorClass = "not-found"
+	ErrorClassConflict  ErrorClass = "conflict"
+	ErrorClassQuota     ErrorClass = "quota"
+	ErrorClassAuth      ErrorClass = "auth"
+	ErrorClassTransient ErrorClass = "transient"
+	ErrorClassUnknown   ErrorClass = "unknown"
+)
+
+// APIError is an error returned by an IBM Cloud API, with the request IDs that
+// IBM Cloud support needs to investigate it.
+type APIError struct {
+	Err           error
+	Class         ErrorClass
+	StatusCode    int
+	Code          string
+	RequestID     string
+	CorrelationID string
+	Service       string
+	Operation     string
+	ResourceType  string
+	ResourceID    string
+}
+
+// NewAPIError builds the APIError of a failed call to the operation of the
+// service, made for the resource of the given type and ID.
+func NewAPIError(err error, response *core.DetailedResponse, service, operation, resourceType, resourceID string) *APIError {
+	if err == nil {
+		// Some operations report success without returning the expected result
+		err = errors.New("empty result")
+	}
+	apiErr := &APIError{
+		Err:          err,
+		Service:      service,
+		Operation:    operation,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+	}
+	if response != nil {
+		apiErr.StatusCode = response.StatusCode
+		apiErr.Code = errorCode(response.Result)
+		apiErr.RequestID = firstHeader(response.Headers, "X-Request-Id", "X-Global-Transaction-Id", "Transaction-Id")
+		apiErr.CorrelationID = firstHeader(response.Headers, "X-Correlation-Id")
+	} else if bmxErr, ok := err.(bmxerror.RequestFailure); ok {
+		apiErr.StatusCode = bmxErr.StatusCode()
+		apiErr.Code = bmxErr.Code()
+	}
+	apiErr.Class = classifyError(err, apiErr.StatusCode, apiErr.Code)
+	return apiErr
+}
+
+// APIErrorDiag returns the diagnostics of a failed API call, see NewAPIError.
+func APIErrorDiag(err error, response *core.DetailedResponse, service, operation, resourceType, resourceID string) diag.Diagnostics {
+	return diag.Diagnostics{NewAPIError(err, response, service, operation, resourceType, resourceID).Diagnostic()}
+}
+
+// DiagFromErr is diag.FromErr keeping the detail of an APIError.
+func DiagFromErr(err error) diag.Diagnostics {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return diag.Diagnostics{apiErr.Diagnostic()}
+	}
+	return diag.FromErr(err)
+}
+
+// APIErrorContextFunc adapts a CRUD function that returns an error, so that the
+// diagnostic of an APIError it returns keeps the status, request IDs and class.
+// The context is passed on, so that the API calls made with it are cancelled
+// with the operation and attributed to the resource in the audit log.
+func APIErrorContextFunc(f func(context.Context, *schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return DiagFromErr(f(ctx, d, meta))
+	}
+}
+
+// ErrorClassOf returns the class of an APIError, or ErrorClassUnknown for other errors.
+func ErrorClassOf(err error) ErrorClass {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Class
+	}
+	return ErrorClassUnknown
+}
+
+// Summary is the one line description of the error.
+func (e *APIError) Summary() string {
+	return fmt.Sprintf("[ERROR] %s failed: %s", e.Operation, e.Err)
+}
+
+// Detail lists the status, request IDs, class and context of the error.
+func (e *APIError) Detail() string {
+	var detail strings.Builder
+	line := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(&detail, "%-15s %s\n", name+":", value)
+		}
+	}
+	if e.StatusCode != 0 {
+		line("HTTP status", fmt.Sprintf("%d", e.StatusCode))
+	}
+	line("Error code", e.Code)
+	line("Request ID", e.RequestID)
+	line("Correlation ID", e.CorrelationID)
+	line("Service", e.Service)
+	line("Operation", e.Operation)
+	if e.ResourceID != "" {
+		line("Resource", fmt.Sprintf("%s (ID %s)", e.ResourceType, e.ResourceID))
+	} else {
+		line("Resource", e.ResourceType)
+	}
+	line("Error class", string(e.Class))
+	return strings.TrimSuffix(detail.String(), "\n")
+}
+
+// Diagnostic returns the error as a Terraform diagnostic.
+func (e *APIError) Diagnostic() diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  e.Summary(),
+		Detail:   e.Detail(),
+	}
+}
+
+func (e *APIError) Error() string {
+	return e.Summary() + "\n" + e.Detail()
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+func firstHeader(headers map[string][]string, names ...string) string {
+	for _, name := range names {
+		for key, values := range headers {
+			if strings.EqualFold(key, name) && len(values) > 0 && values[0] != "" {
+				return values[0]
+			}
+		}
+	}
+	return ""
+}
+
+// errorCode returns the code of the first error of an IBM Cloud API error body.
+func errorCode(result interface{}) string {
+	body, ok := result.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	if errs, ok := body["errors"].([]interface{}); ok && len(errs) > 0 {
+		if first, ok := errs[0].(map[string]interface{}); ok {
+			if code, ok := first["code"].(string); ok {
+				return code
+			}
+		}
+	}
+	for _, key := range []string{"code", "errorCode"} {
+		if code, ok := body[key].(string); ok {
+			return code
+		}
+	}
+	return ""
+}
+
+func classifyError(err error, statusCode int, code string) ErrorClass {
+	if statusCode >= 400 && statusCode < 500 && statusCode != 429 {
+		text := strings.ToLower(code + " " + err.Error())
+		if strings.Contains(text, "quota") || strings.Contains(text, "limit exceeded") || strings.Contains(text, "limit_exceeded") {
+			return ErrorClassQuota
+		}
+	}
+	switch statusCode {
+	case 404, 410:
+		return ErrorClassNotFound
+	case 409, 412:
+		return ErrorClassConflict
+	case 401, 403:
+		return ErrorClassAuth
+	case 408, 429, 500, 502, 503, 504:
+		return ErrorClassTransient
+	case 0:
+		var netErr net.Error
+		if errors.As(err, &netErr) {
+			return ErrorClassTransient
+		}
+	}
+	return ErrorClassUnknown
+}
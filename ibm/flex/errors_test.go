@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestNewAPIErrorClass(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		response *core.DetailedResponse
+		class    ErrorClass
+		code     string
+	}{
+		{name: "not found", err: errors.New("VPC not found"), response: &core.DetailedResponse{StatusCode: 404}, class: ErrorClassNotFound},
+		{name: "gone", err: errors.New("gone"), response: &core.DetailedResponse{StatusCode: 410}, class: ErrorClassNotFound},
+		{name: "conflict", err: errors.New("in use"), response: &core.DetailedResponse{StatusCode: 409}, class: ErrorClassConflict},
+		{name: "precondition failed", err: errors.New("etag mismatch"), response: &core.DetailedResponse{StatusCode: 412}, class: ErrorClassConflict},
+		{name: "unauthorized", err: errors.New("expired token"), response: &core.DetailedResponse{StatusCode: 401}, class: ErrorClassAuth},
+		{name: "forbidden", err: errors.New("not authorized"), response: &core.DetailedResponse{StatusCode: 403}, class: ErrorClassAuth},
+		{name: "rate limited", err: errors.New("too many requests"), response: &core.DetailedResponse{StatusCode: 429}, class: ErrorClassTransient},
+		{name: "bad gateway", err: errors.New("bad gateway"), response: &core.DetailedResponse{StatusCode: 502}, class: ErrorClassTransient},
+		{name: "unavailable", err: errors.New("unavailable"), response: &core.DetailedResponse{StatusCode: 503}, class: ErrorClassTransient},
+		{name: "bad request", err: errors.New("invalid name"), response: &core.DetailedResponse{StatusCode: 400}, class: ErrorClassUnknown},
+		{
+			name:     "quota code",
+			err:      errors.New("cannot create"),
+			response: &core.DetailedResponse{StatusCode: 400, Result: map[string]interface{}{"errors": []interface{}{map[string]interface{}{"code": "vpc_quota_exceeded"}}}},
+			class:    ErrorClassQuota,
+			code:     "vpc_quota_exceeded",
+		},
+		{name: "quota message on forbidden", err: errors.New("Limit exceeded for instances"), response: &core.DetailedResponse{StatusCode: 403}, class: ErrorClassQuota},
+		{name: "rate limit is not quota", err: errors.New("rate limit exceeded"), response: &core.DetailedResponse{StatusCode: 429}, class: ErrorClassTransient},
+		{name: "network error", err: fmt.Errorf("Get: %w", timeoutError{}), class: ErrorClassTransient},
+		{name: "no response", err: errors.New("boom"), class: ErrorClassUnknown},
+		{name: "bluemix error", err: bmxerror.NewRequestFailure("ResourceNotFound", "cluster not found", 404), class: ErrorClassNotFound, code: "ResourceNotFound"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			apiErr := NewAPIError(c.err, c.response, "vpc", "CreateVPC", "ibm_is_vpc", "")
+			if apiErr.Class != c.class {
+				t.Fatalf("expected class %s, got %s", c.class, apiErr.Class)
+			}
+			if apiErr.Code != c.code {
+				t.Fatalf("expected code %q, got %q", c.code, apiErr.Code)
+			}
+			if ErrorClassOf(fmt.Errorf("wrapped: %w", apiErr)) != c.class {
+				t.Fatalf("expected the class to survive wrapping")
+			}
+		})
+	}
+}
+
+func TestNewAPIErrorRequestIDs(t *testing.T) {
+	cases := []struct {
+		name          string
+		headers       map[string][]string
+		requestID     string
+		correlationID string
+	}{
+		{name: "request id", headers: map[string][]string{"X-Request-Id": {"req-1"}}, requestID: "req-1"},
+		{name: "lower case header", headers: map[string][]string{"x-request-id": {"req-2"}}, requestID: "req-2"},
+		{name: "global transaction id", headers: map[string][]string{"X-Global-Transaction-Id": {"txn-1"}}, requestID: "txn-1"},
+		{name: "request id first", headers: map[string][]string{"Transaction-Id": {"txn-2"}, "X-Request-Id": {"req-3"}}, requestID: "req-3"},
+		{name: "empty value skipped", headers: map[string][]string{"X-Request-Id": {""}, "Transaction-Id": {"txn-3"}}, requestID: "txn-3"},
+		{name: "correlation id", headers: map[string][]string{"X-Request-Id": {"req-4"}, "X-Correlation-Id": {"corr-1"}}, requestID: "req-4", correlationID: "corr-1"},
+		{name: "no headers"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			response := &core.DetailedResponse{StatusCode: 500, Headers: c.headers}
+			apiErr := NewAPIError(errors.New("internal error"), response, "vpc", "GetVPC", "ibm_is_vpc", "r006-1")
+			if apiErr.RequestID != c.requestID || apiErr.CorrelationID != c.correlationID {
+				t.Fatalf("expected request ID %q and correlation ID %q, got %q and %q", c.requestID, c.correlationID, apiErr.RequestID, apiErr.CorrelationID)
+			}
+			detail := apiErr.Detail()
+			if c.requestID != "" && !strings.Contains(detail, "Request ID:     "+c.requestID) {
+				t.Fatalf("expected the request ID in the detail, got %q", detail)
+			}
+			if c.requestID == "" && strings.Contains(detail, "Request ID") {
+				t.Fatalf("expected no request ID line, got %q", detail)
+			}
+		})
+	}
+}
+
+func TestAPIErrorDiagnostic(t *testing.T) {
+	apiErr := NewAPIError(nil, &core.DetailedResponse{StatusCode: 200}, "vpc", "GetVPC", "ibm_is_vpc", "r006-1")
+	diags := DiagFromErr(fmt.Errorf("reading: %w", apiErr))
+	if len(diags) != 1 || diags[0].Summary != "[ERROR] GetVPC failed: empty result" {
+		t.Fatalf("unexpected diagnostics %#v", diags)
+	}
+	if !strings.Contains(diags[0].Detail, "Resource:       ibm_is_vpc (ID r006-1)") {
+		t.Fatalf("expected the resource in the detail, got %q", diags[0].Detail)
+	}
+	if diags := DiagFromErr(errors.New("plain")); len(diags) != 1 || diags[0].Summary != "plain" {
+		t.Fatalf("expected a plain error to keep its message, got %#v", diags)
+	}
+}
+
+func TestAPIErrorContextFunc(t *testing.T) {
+	type ctxKey struct{}
+	read := APIErrorContextFunc(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		if ctx.Value(ctxKey{}) == nil {
+			return errors.New("expected the context of the operation")
+		}
+		return fmt.Errorf("[ERROR] Error waiting for subnet: %w", NewAPIError(errors.New("not found"), &core.DetailedResponse{
+			StatusCode: 404,
+			Headers:    map[string][]string{"X-Request-Id": {"req-5"}},
+		}, "vpc", "GetSubnet", "ibm_is_subnet", "0717-1"))
+	})
+	diags := read(context.WithValue(context.Background(), ctxKey{}, true), nil, nil)
+	if len(diags) != 1 || diags[0].Summary != "[ERROR] GetSubnet failed: not found" || !strings.Contains(diags[0].Detail, "req-5") {
+		t.Fatalf("expected the API error diagnostic, got %#v", diags)
+	}
+	ok := APIErrorContextFunc(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error { return nil })
+	if diags := ok(context.Background(), nil, nil); diags != nil {
+		t.Fatalf("expected no diagnostics, got %#v", diags)
+	}
+}
@@ -2366,6 +2366,9 @@ func GetGlobalTagsUsingCRN(meta interface{}, resourceID, resourceType, tagType s
 	if err != nil {
 		return nil, err
 	}
+	if strings.TrimSpace(tagType) == "" || tagType == "user" {
+		taggingResult = stripDefaultTags(taggingResult, meta)
+	}
 	return taggingResult, nil
 }
 
@@ -2459,8 +2462,9 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 		var envTags []string
 		if schematicTags != "" {
 			envTags = strings.Split(schematicTags, ",")
-			add = append(add, envTags...)
+			add = appendMissingTags(add, envTags)
 		}
+		add, remove = mergeDefaultTags(add, remove, meta)
 	}
 
 	if len(remove) > 0 {
@@ -2529,7 +2533,7 @@ func GetTagsUsingCRN(meta interface{}, resourceCRN string) (*schema.Set, error)
 	if err != nil {
 		return nil, err
 	}
-	return taggingResult, nil
+	return stripDefaultTags(taggingResult, meta), nil
 }
 
 func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceCRN string) error {
@@ -2560,8 +2564,9 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 	var envTags []string
 	if schematicTags != "" {
 		envTags = strings.Split(schematicTags, ",")
-		add = append(add, envTags...)
+		add = appendMissingTags(add, envTags)
 	}
+	add, remove = mergeDefaultTags(add, remove, meta)
 
 	if len(remove) > 0 {
 		_, err := gtClient.Tags().DetachTags(resourceCRN, remove)
@@ -2605,7 +2610,7 @@ func FlattenSSLCiphers(ciphers []datatypes.Network_LBaaS_SSLCipher) *schema.Set
 	return NewStringSet(schema.HashString, c)
 }
 
-func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
+func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
 
 	if diff.Id() != "" && diff.HasChange("tags") {
 		o, n := diff.GetChange("tags")
@@ -2617,13 +2622,160 @@ func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 			s := strings.Split(v, ",")
 			if len(removeInt) == len(s) && len(addInt) == 0 {
 				fmt.Println("Suppresing the TAG diff ")
-				return diff.Clear("tags")
+				if err := diff.Clear("tags"); err != nil {
+					return err
+				}
 			}
 		}
+		// Provider default_tags are attached on every update and dropped from the tags
+		// read, so a change made only of default tags is not a change of the resource tags.
+		if defaultTags := providerDefaultTags(meta); len(defaultTags) > 0 {
+			defaultSet := NewStringSet(ResourceIBMVPCHash, defaultTags)
+			if isSubsetOf(removeInt, defaultSet) && isSubsetOf(addInt, defaultSet) {
+				log.Printf("[INFO] Suppressing the tags diff, %v are inherited from the provider default_tags", defaultTags)
+				if err := diff.Clear("tags"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return resourceTagsAllCustomizeDiff(diff, meta)
+}
+
+// ResourceTagsAll is the computed attribute of the resources that inherit the provider
+// default_tags, it holds every tag attached to the resource.
+const ResourceTagsAll = "tags_all"
+
+// ResourceTagsAllSchema returns the schema of the tags_all attribute.
+func ResourceTagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Set:         ResourceIBMVPCHash,
+		Description: "List of tags attached to the resource, including the tags inherited from the provider default_tags",
+	}
+}
+
+// resourceTagsAllCustomizeDiff plans tags_all, on the resources that have it, as the resource
+// tags merged with the provider default_tags, so that a change of default_tags shows up as a
+// diff on existing resources.
+func resourceTagsAllCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if t := diff.GetRawConfig().Type(); !t.IsObjectType() || !t.HasAttribute(ResourceTagsAll) {
+		return nil
+	}
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed(ResourceTagsAll)
+	}
+	tags, _ := diff.Get("tags").(*schema.Set)
+	tagsAll := NewStringSet(ResourceIBMVPCHash, []string{})
+	if tags != nil {
+		for _, tag := range tags.List() {
+			tagsAll.Add(tag)
+		}
+	}
+	for _, tag := range providerDefaultTags(meta) {
+		tagsAll.Add(tag)
+	}
+	if v := os.Getenv("IC_ENV_TAGS"); v != "" {
+		for _, tag := range strings.Split(v, ",") {
+			tagsAll.Add(tag)
+		}
+	}
+	if o, _ := diff.GetChange(ResourceTagsAll); o != nil && o.(*schema.Set).Equal(tagsAll) {
+		return nil
+	}
+	return diff.SetNew(ResourceTagsAll, tagsAll.List())
+}
+
+// providerDefaultTags returns the default_tags configured on the provider block.
+func providerDefaultTags(meta interface{}) []string {
+	if session, ok := meta.(conns.ClientSession); ok && session != nil {
+		return session.DefaultTags()
 	}
 	return nil
 }
 
+// HasDefaultTags reports whether default_tags are configured on the provider block,
+// in which case tags have to be attached even when the resource sets none.
+func HasDefaultTags(meta interface{}) bool {
+	return len(providerDefaultTags(meta)) > 0
+}
+
+// WithDefaultTags returns the user tags to set on a resource that takes them in its own
+// API, which are the tags configured on the resource together with the provider default_tags.
+func WithDefaultTags(tags []string, meta interface{}) []string {
+	return appendMissingTags(tags, providerDefaultTags(meta))
+}
+
+// WithoutDefaultTags drops the provider default_tags from the user tags read for a resource
+// that returns them in its own API.
+func WithoutDefaultTags(tags []string, meta interface{}) []string {
+	defaultTags := providerDefaultTags(meta)
+	if len(defaultTags) == 0 {
+		return tags
+	}
+	defaultSet := NewStringSet(ResourceIBMVPCHash, defaultTags)
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if !defaultSet.Contains(tag) {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// mergeDefaultTags adds the provider default_tags to the tags to attach and
+// makes sure they are never detached when they are dropped from the resource.
+func mergeDefaultTags(add, remove []string, meta interface{}) ([]string, []string) {
+	defaultTags := providerDefaultTags(meta)
+	if len(defaultTags) == 0 {
+		return add, remove
+	}
+	defaultSet := NewStringSet(ResourceIBMVPCHash, defaultTags)
+	keep := make([]string, 0, len(remove))
+	for _, tag := range remove {
+		if !defaultSet.Contains(tag) {
+			keep = append(keep, tag)
+		}
+	}
+	return appendMissingTags(add, defaultTags), keep
+}
+
+// stripDefaultTags drops the provider default_tags from the tags read for a
+// resource, so that inherited tags are never reported as resource tags.
+func stripDefaultTags(tags *schema.Set, meta interface{}) *schema.Set {
+	defaultTags := providerDefaultTags(meta)
+	if tags == nil || len(defaultTags) == 0 {
+		return tags
+	}
+	for _, tag := range defaultTags {
+		tags.Remove(tag)
+	}
+	return tags
+}
+
+func isSubsetOf(tags []interface{}, set *schema.Set) bool {
+	for _, tag := range tags {
+		if !set.Contains(tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// appendMissingTags appends the tags that are not in the list yet.
+func appendMissingTags(list, tags []string) []string {
+	present := NewStringSet(ResourceIBMVPCHash, list)
+	for _, tag := range tags {
+		if !present.Contains(tag) {
+			present.Add(tag)
+			list = append(list, tag)
+		}
+	}
+	return list
+}
+
 func ResourceValidateAccessTags(diff *schema.ResourceDiff, meta interface{}) error {
 
 	if value, ok := diff.GetOkExists("access_tags"); ok {
@@ -2746,6 +2898,9 @@ func ResourceVolumeAttachmentValidate(diff *schema.ResourceDiff) error {
 	return nil
 }
 
+// InstanceProfileValidate replaces the instance when its profile moves between
+// profiles with and without instance storage, the ones with a d in their name.
+// It changes the plan rather than rejecting it, so it is not a ValidateConstraint.
 func InstanceProfileValidate(diff *schema.ResourceDiff) error {
 	if diff.Id() != "" && diff.HasChange("profile") {
 		o, n := diff.GetChange("profile")
@@ -2762,17 +2917,6 @@ func InstanceProfileValidate(diff *schema.ResourceDiff) error {
 	return nil
 }
 
-func ResourceIPSecPolicyValidate(diff *schema.ResourceDiff) error {
-
-	newEncAlgo := diff.Get("encryption_algorithm").(string)
-	newAuthAlgo := diff.Get("authentication_algorithm").(string)
-	if (newEncAlgo == "aes128gcm16" || newEncAlgo == "aes192gcm16" || newEncAlgo == "aes256gcm16") && newAuthAlgo != "disabled" {
-		return fmt.Errorf("authentication_algorithm must be set to 'disabled' when the encryption_algorithm is either one of 'aes128gcm16', 'aes192gcm16', 'aes256gcm16'")
-	}
-
-	return nil
-}
-
 func ResourceVolumeValidate(diff *schema.ResourceDiff) error {
 
 	if diff.Id() != "" && diff.HasChange("capacity") {
@@ -2793,15 +2937,6 @@ func ResourceVolumeValidate(diff *schema.ResourceDiff) error {
 		capacity = int64(capacityOk.(int))
 	}
 
-	if capacity == int64(0) {
-		capacity = int64(100)
-	}
-	if profile == "5iops-tier" && capacity > 9600 {
-		return fmt.Errorf("'%s' storage block supports capacity up to %d.", profile, 9600)
-	} else if profile == "10iops-tier" && capacity > 4800 {
-		return fmt.Errorf("'%s' storage block supports capacity up to %d.", profile, 4800)
-	}
-
 	if iopsOk, ok := diff.GetOk("iops"); ok {
 		iops = int64(iopsOk.(int))
 	}
@@ -2813,11 +2948,9 @@ func ResourceVolumeValidate(diff *schema.ResourceDiff) error {
 		}
 	}
 
-	if profile != "custom" {
-		if iops != 0 && diff.NewValueKnown("iops") && diff.HasChange("iops") {
-			return fmt.Errorf("VolumeError : iops is applicable for only custom volume profiles")
-		}
-	} else {
+	// The capacity of the tiered profiles and the iops of the non custom ones are
+	// constrained declaratively in the resource validators
+	if profile == "custom" {
 		if capacity == 0 {
 			capacity = int64(100)
 		}
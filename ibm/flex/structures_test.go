@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+type defaultTagsSession struct {
+	conns.ClientSession
+	tags []string
+}
+
+func (s defaultTagsSession) DefaultTags() []string {
+	return s.tags
+}
+
+func sortedTags(set *schema.Set) []string {
+	if set == nil {
+		return nil
+	}
+	tags := ExpandStringList(set.List())
+	sort.Strings(tags)
+	return tags
+}
+
+func equalTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	cases := []struct {
+		name           string
+		add            []string
+		remove         []string
+		defaultTags    []string
+		expectedAdd    []string
+		expectedRemove []string
+	}{
+		{name: "no default tags", add: []string{"app:web"}, remove: []string{"app:api"}, expectedAdd: []string{"app:web"}, expectedRemove: []string{"app:api"}},
+		{name: "default tags attached", add: []string{"app:web"}, defaultTags: []string{"env:prod", "owner:platform"}, expectedAdd: []string{"app:web", "env:prod", "owner:platform"}, expectedRemove: []string{}},
+		{name: "default tags attached without changes", defaultTags: []string{"env:prod"}, expectedAdd: []string{"env:prod"}, expectedRemove: []string{}},
+		{name: "configured default tag not attached twice", add: []string{"env:prod"}, defaultTags: []string{"env:prod"}, expectedAdd: []string{"env:prod"}, expectedRemove: []string{}},
+		{name: "default tag dropped from the resource kept", remove: []string{"env:prod", "app:api"}, defaultTags: []string{"env:prod"}, expectedAdd: []string{"env:prod"}, expectedRemove: []string{"app:api"}},
+		{name: "removed default tag detached", remove: []string{"env:dev"}, defaultTags: []string{"env:prod"}, expectedAdd: []string{"env:prod"}, expectedRemove: []string{"env:dev"}},
+		{name: "case insensitive", add: []string{"ENV:prod"}, remove: []string{"ENV:PROD"}, defaultTags: []string{"env:prod"}, expectedAdd: []string{"ENV:prod"}, expectedRemove: []string{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			add, remove := mergeDefaultTags(c.add, c.remove, defaultTagsSession{tags: c.defaultTags})
+			sort.Strings(add)
+			sort.Strings(remove)
+			if !equalTags(add, c.expectedAdd) {
+				t.Fatalf("expected to attach %v, got %v", c.expectedAdd, add)
+			}
+			if !equalTags(remove, c.expectedRemove) {
+				t.Fatalf("expected to detach %v, got %v", c.expectedRemove, remove)
+			}
+		})
+	}
+}
+
+func TestWithoutDefaultTags(t *testing.T) {
+	cases := []struct {
+		name        string
+		tags        []string
+		defaultTags []string
+		expected    []string
+	}{
+		{name: "no default tags", tags: []string{"app:web", "env:prod"}, expected: []string{"app:web", "env:prod"}},
+		{name: "inherited tag dropped", tags: []string{"app:web", "env:prod"}, defaultTags: []string{"env:prod"}, expected: []string{"app:web"}},
+		{name: "removed default tag reported", tags: []string{"app:web", "env:dev"}, defaultTags: []string{"env:prod"}, expected: []string{"app:web", "env:dev"}},
+		{name: "case insensitive", tags: []string{"ENV:PROD"}, defaultTags: []string{"env:prod"}, expected: []string{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			meta := defaultTagsSession{tags: c.defaultTags}
+			actual := WithoutDefaultTags(c.tags, meta)
+			sort.Strings(actual)
+			if !equalTags(actual, c.expected) {
+				t.Fatalf("expected %v, got %v", c.expected, actual)
+			}
+			if actual := sortedTags(stripDefaultTags(NewStringSet(ResourceIBMVPCHash, c.tags), meta)); !equalTags(actual, c.expected) {
+				t.Fatalf("expected the tags set to be %v, got %v", c.expected, actual)
+			}
+		})
+	}
+	if stripDefaultTags(nil, defaultTagsSession{tags: []string{"env:prod"}}) != nil {
+		t.Fatalf("expected nil tags to stay nil")
+	}
+}
+
+func testResourceTagsDiff(t *testing.T, r *schema.Resource, state *terraform.InstanceState, tags []interface{}, defaultTags []string) map[string]*terraform.ResourceAttrDiff {
+	t.Helper()
+	state.RawConfig = cty.NullVal(r.CoreConfigSchema().ImpliedType())
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tags": tags,
+	})
+	diff, err := r.Diff(context.Background(), state, config, defaultTagsSession{tags: defaultTags})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil {
+		return nil
+	}
+	return diff.Attributes
+}
+
+func TestResourceTagsCustomizeDiff(t *testing.T) {
+	tagsSchema := func(withTagsAll bool) *schema.Resource {
+		r := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      ResourceIBMVPCHash,
+				},
+			},
+			CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return ResourceTagsCustomizeDiff(diff, meta)
+			},
+		}
+		if withTagsAll {
+			r.Schema[ResourceTagsAll] = ResourceTagsAllSchema()
+		}
+		return r
+	}
+	newState := func(tags, tagsAll []string) *terraform.InstanceState {
+		state := &terraform.InstanceState{
+			ID:         "r1",
+			Attributes: map[string]string{"id": "r1", "tags.#": fmt.Sprint(len(tags))},
+		}
+		for _, tag := range tags {
+			state.Attributes[fmt.Sprintf("tags.%d", ResourceIBMVPCHash(tag))] = tag
+		}
+		if tagsAll != nil {
+			state.Attributes["tags_all.#"] = fmt.Sprint(len(tagsAll))
+			for _, tag := range tagsAll {
+				state.Attributes[fmt.Sprintf("tags_all.%d", ResourceIBMVPCHash(tag))] = tag
+			}
+		}
+		return state
+	}
+	t.Setenv("IC_ENV_TAGS", "")
+
+	attrs := testResourceTagsDiff(t, tagsSchema(true), newState([]string{"app:web"}, []string{"app:web", "env:dev"}), []interface{}{"app:web"}, []string{"env:prod"})
+	planned := map[string]bool{}
+	for k, attr := range attrs {
+		if strings.HasPrefix(k, "tags.") {
+			t.Fatalf("expected no tags diff when the provider default_tags change, got %s", k)
+		}
+		if k != "tags_all.#" && strings.HasPrefix(k, "tags_all.") {
+			planned[attr.New] = !attr.NewRemoved
+		}
+	}
+	if !planned["app:web"] || !planned["env:prod"] || planned["env:dev"] {
+		t.Fatalf("expected tags_all to be planned as [app:web env:prod], got %v", planned)
+	}
+
+	if attrs := testResourceTagsDiff(t, tagsSchema(true), newState([]string{"app:web"}, []string{"app:web", "env:dev"}), []interface{}{"app:web"}, []string{"env:dev"}); len(attrs) > 0 {
+		t.Fatalf("expected no diff when the default_tags are unchanged, got %#v", attrs)
+	}
+
+	if attrs := testResourceTagsDiff(t, tagsSchema(true), newState([]string{"app:web"}, []string{"app:web", "env:prod"}), []interface{}{"app:web", "env:prod"}, []string{"env:prod"}); len(attrs) > 0 {
+		t.Fatalf("expected no diff when a default tag is configured on the resource, got %#v", attrs)
+	}
+
+	attrs = testResourceTagsDiff(t, tagsSchema(false), newState([]string{"app:web"}, nil), []interface{}{"app:web", "env:prod", "app:api"}, []string{"env:prod"})
+	if _, ok := attrs[fmt.Sprintf("tags.%d", ResourceIBMVPCHash("app:api"))]; !ok {
+		t.Fatalf("expected a tags diff for the resource tags on a resource without tags_all, got %#v", attrs)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// auditResource records the resource type, ID and sensitive values in the
+// context of the CRUD operations of the resource, so that the API calls made
+// with that context are attributed to the resource in the audit log. The
+// operations without a context register the sensitive values for redaction
+// while they run.
+func auditResource(name string, resource *schema.Resource) {
+	wrap := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if auditEnabled(meta) {
+				ctx = conns.WithAuditResource(ctx, name, d.Id(), sensitiveValues(resource.Schema, d))
+			}
+			return f(ctx, d, meta)
+		}
+	}
+	resource.CreateContext = wrap(resource.CreateContext)
+	resource.ReadContext = wrap(resource.ReadContext)
+	resource.UpdateContext = wrap(resource.UpdateContext)
+	resource.DeleteContext = wrap(resource.DeleteContext)
+	resource.CreateWithoutTimeout = wrap(resource.CreateWithoutTimeout)
+	resource.ReadWithoutTimeout = wrap(resource.ReadWithoutTimeout)
+	resource.UpdateWithoutTimeout = wrap(resource.UpdateWithoutTimeout)
+	resource.DeleteWithoutTimeout = wrap(resource.DeleteWithoutTimeout)
+
+	wrapLegacy := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			if auditEnabled(meta) {
+				defer conns.RegisterAuditSecrets(sensitiveValues(resource.Schema, d))()
+			}
+			return f(d, meta)
+		}
+	}
+	resource.Create = wrapLegacy(resource.Create)
+	resource.Read = wrapLegacy(resource.Read)
+	resource.Update = wrapLegacy(resource.Update)
+	resource.Delete = wrapLegacy(resource.Delete)
+}
+
+// auditEnabled reports whether the provider configuration of the operation has an audit log.
+func auditEnabled(meta interface{}) bool {
+	session, ok := meta.(conns.ClientSession)
+	return ok && session.AuditEnabled()
+}
+
+// sensitiveValues returns the values of the attributes marked Sensitive in the schema.
+func sensitiveValues(resourceSchema map[string]*schema.Schema, d *schema.ResourceData) []string {
+	var values []string
+	for key, s := range resourceSchema {
+		values = appendSensitiveValues(values, s, d.Get(key), s.Sensitive)
+	}
+	return values
+}
+
+func appendSensitiveValues(values []string, s *schema.Schema, v interface{}, sensitive bool) []string {
+	switch v := v.(type) {
+	case nil:
+	case []interface{}:
+		for _, item := range v {
+			values = appendSensitiveValues(values, s, item, sensitive)
+		}
+	case *schema.Set:
+		for _, item := range v.List() {
+			values = appendSensitiveValues(values, s, item, sensitive)
+		}
+	case map[string]interface{}:
+		elem, isResource := s.Elem.(*schema.Resource)
+		for key, item := range v {
+			if isResource {
+				if nested, ok := elem.Schema[key]; ok {
+					values = appendSensitiveValues(values, nested, item, sensitive || nested.Sensitive)
+				}
+			} else {
+				values = appendSensitiveValues(values, s, item, sensitive)
+			}
+		}
+	case string:
+		if sensitive && v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAuditResourceRedactsLegacyResource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	logger, err := conns.NewAuditLogger(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logger.Close()
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"passphrase": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+		// A legacy read without a context, the API call cannot be attributed to the resource
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			client := &http.Client{Transport: conns.NewAuditTransport(conns.DefaultTransport(), logger)}
+			resp, err := client.Get(server.URL + "/v1/keys/" + d.Get("passphrase").(string))
+			if err != nil {
+				return err
+			}
+			return resp.Body.Close()
+		},
+	}
+	auditResource("ibm_test_legacy", resource)
+
+	d := resource.TestResourceData()
+	d.SetId("key-1")
+	d.Set("name", "my-key")
+	d.Set("passphrase", "correct-horse-battery")
+	if err := resource.Read(d, auditSession{}); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := string(content)
+	if strings.Contains(log, "correct-horse-battery") {
+		t.Fatalf("expected the sensitive attribute to be redacted, got %s", log)
+	}
+	if !strings.Contains(log, "/v1/keys/{redacted}") {
+		t.Fatalf("expected the call of the legacy read to be logged with the secret redacted, got %s", log)
+	}
+}
+
+// auditSession is the provider configuration of a test with an audit log
+type auditSession struct {
+	conns.ClientSession
+}
+
+func (auditSession) AuditEnabled() bool {
+	return true
+}
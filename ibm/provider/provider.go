@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/apigateway"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appconfiguration"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appid"
@@ -53,11 +54,12 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider returns a *schema.Provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"bluemix_api_key": {
 				Type:        schema.TypeString,
@@ -161,6 +163,114 @@ func Provider() *schema.Provider {
 				Description: "The retry count to set for API calls.",
 				DefaultFunc: schema.EnvDefaultFunc("MAX_RETRIES", 10),
 			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Retry policy applied to every IBM Cloud API call made by the provider",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Total number of attempts for an API call, including the first one. Defaults to max_retries + 1",
+						},
+						"min_delay": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Base delay (in seconds) of the exponential backoff between attempts",
+						},
+						"max_delay": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum delay (in seconds) between attempts. A Retry-After header sent by the API takes precedence",
+						},
+						"retryable_status_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(400, 599)},
+							Description: "HTTP status codes that are retried",
+						},
+					},
+				},
+			},
+			"transport": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Connection pool, proxy and TLS settings of the HTTP transport shared by every IBM Cloud API client",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_idle_conns": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Maximum number of idle keep-alive connections across all hosts",
+						},
+						"max_idle_conns_per_host": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of idle keep-alive connections kept for each host",
+						},
+						"max_conns_per_host": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Maximum number of connections to each host, 0 means no limit",
+						},
+						"idle_conn_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      90,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Time (in seconds) after which an idle connection is closed",
+						},
+						"proxy_url": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "URL of the proxy used for every API call, overriding the HTTPS_PROXY and HTTP_PROXY environment variables",
+						},
+						"ca_bundle_file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Path of a PEM file with certificate authorities trusted in addition to the system ones",
+						},
+						"client_cert_file": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"transport.0.client_key_file"},
+							Description:  "Path of a PEM client certificate presented to servers that ask for one",
+						},
+						"client_key_file": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"transport.0.client_cert_file"},
+							Description:  "Path of the PEM private key of the client certificate",
+						},
+					},
+				},
+			},
+			"audit_log_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a file to which one JSON line is appended for every IBM Cloud API call, with credentials and sensitive values redacted",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_AUDIT_LOG_PATH", "IBMCLOUD_AUDIT_LOG_PATH"}, nil),
+			},
+			"lock_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum time in seconds a resource waits for another one to release the lock of their shared parent, like a security group or a load balancer. 1200 by default, 0 to wait as long as the timeout of the operation",
+				ValidateFunc: validation.IntAtLeast(0),
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_LOCK_TIMEOUT", "IBMCLOUD_LOCK_TIMEOUT"}, int(conns.DefaultLockTimeout/time.Second)),
+			},
 			"function_namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -213,6 +323,29 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"private_endpoints_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Fail the provider configuration when a service in the endpoints file has no private endpoint, instead of falling back to public endpoints. Requires visibility to be private",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_PRIVATE_ENDPOINTS_ONLY", "IBMCLOUD_PRIVATE_ENDPOINTS_ONLY"}, false),
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags that are attached to every resource that supports tags, in addition to the tags configured on the resource",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "List of tags inherited by every taggable resource",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -500,6 +633,7 @@ func Provider() *schema.Provider {
 			"ibm_is_vpc":                             vpc.DataSourceIBMISVPC(),
 			"ibm_is_vpc_dns_resolution_binding":      vpc.DataSourceIBMIsVPCDnsResolutionBinding(),
 			"ibm_is_vpc_dns_resolution_bindings":     vpc.DataSourceIBMIsVPCDnsResolutionBindings(),
+			"ibm_is_vpc_topology":                    vpc.DataSourceIBMISVPCTopology(),
 			"ibm_is_vpcs":                            vpc.DataSourceIBMISVPCs(),
 			"ibm_is_vpn_gateway":                     vpc.DataSourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateways":                    vpc.DataSourceIBMISVPNGateways(),
@@ -516,6 +650,7 @@ func Provider() *schema.Provider {
 			"ibm_is_vpn_servers":                     vpc.DataSourceIBMIsVPNServers(),
 			"ibm_is_vpn_server_client":               vpc.DataSourceIBMIsVPNServerClient(),
 			"ibm_is_vpn_server_client_configuration": vpc.DataSourceIBMIsVPNServerClientConfiguration(),
+			"ibm_is_vpn_server_client_profile":       vpc.DataSourceIBMIsVPNServerClientProfile(),
 			"ibm_is_vpn_server_clients":              vpc.DataSourceIBMIsVPNServerClients(),
 			"ibm_is_vpn_server_route":                vpc.DataSourceIBMIsVPNServerRoute(),
 			"ibm_is_vpn_server_routes":               vpc.DataSourceIBMIsVPNServerRoutes(),
@@ -1044,11 +1179,14 @@ func Provider() *schema.Provider {
 			"ibm_is_lb_listener_policy_rule":                vpc.ResourceIBMISLBListenerPolicyRule(),
 			"ibm_is_lb_pool":                                vpc.ResourceIBMISLBPool(),
 			"ibm_is_lb_pool_member":                         vpc.ResourceIBMISLBPoolMember(),
+			"ibm_is_lb_pool_members":                        vpc.ResourceIBMISLBPoolMembers(),
 			"ibm_is_network_acl":                            vpc.ResourceIBMISNetworkACL(),
 			"ibm_is_network_acl_rule":                       vpc.ResourceIBMISNetworkACLRule(),
+			"ibm_is_network_acl_rules":                      vpc.ResourceIBMISNetworkACLRules(),
 			"ibm_is_public_gateway":                         vpc.ResourceIBMISPublicGateway(),
 			"ibm_is_security_group":                         vpc.ResourceIBMISSecurityGroup(),
 			"ibm_is_security_group_rule":                    vpc.ResourceIBMISSecurityGroupRule(),
+			"ibm_is_security_group_rules":                   vpc.ResourceIBMISSecurityGroupRules(),
 			"ibm_is_security_group_target":                  vpc.ResourceIBMISSecurityGroupTarget(),
 			"ibm_is_share":                                  vpc.ResourceIbmIsShare(),
 			"ibm_is_share_replica_operations":               vpc.ResourceIbmIsShareReplicaOperations(),
@@ -1068,6 +1206,7 @@ func Provider() *schema.Provider {
 			"ibm_is_vpc_dns_resolution_binding":             vpc.ResourceIBMIsVPCDnsResolutionBinding(),
 			"ibm_is_vpc_routing_table":                      vpc.ResourceIBMISVPCRoutingTable(),
 			"ibm_is_vpc_routing_table_route":                vpc.ResourceIBMISVPCRoutingTableRoute(),
+			"ibm_is_vpc_routing_table_routes":               vpc.ResourceIBMISVPCRoutingTableRoutes(),
 			"ibm_is_vpn_server":                             vpc.ResourceIBMIsVPNServer(),
 			"ibm_is_vpn_server_client":                      vpc.ResourceIBMIsVPNServerClient(),
 			"ibm_is_vpn_server_route":                       vpc.ResourceIBMIsVPNServerRoute(),
@@ -1347,6 +1486,14 @@ func Provider() *schema.Provider {
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	for name, resource := range provider.ResourcesMap {
+		auditResource(name, resource)
+	}
+	for name, resource := range provider.DataSourcesMap {
+		auditResource(name, resource)
+	}
+	return provider
 }
 
 var (
@@ -1745,11 +1892,45 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if f, ok := d.GetOk("endpoints_file_path"); ok {
 		file = f.(string)
 	}
+	var defaultTags []string
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		defaultTagsMap := v.([]interface{})[0].(map[string]interface{})
+		if tags, ok := defaultTagsMap["tags"]; ok {
+			defaultTags = flex.ExpandStringList(tags.(*schema.Set).List())
+		}
+	}
+
+	privateEndpointsOnly := d.Get("private_endpoints_only").(bool)
+	var auditLogPath string
+	if v, ok := d.GetOk("audit_log_path"); ok {
+		auditLogPath = v.(string)
+	}
+	lockTimeout := time.Duration(d.Get("lock_timeout").(int)) * time.Second
+	retryCount := d.Get("max_retries").(int)
+	var retryPolicy *conns.RetryPolicy
+	if v, ok := d.GetOk("retry"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		retryMap := v.([]interface{})[0].(map[string]interface{})
+		maxAttempts := retryCount + 1
+		if attempts, ok := retryMap["max_attempts"]; ok && attempts.(int) > 0 {
+			maxAttempts = attempts.(int)
+		}
+		var statusCodes []int
+		if codes, ok := retryMap["retryable_status_codes"]; ok {
+			for _, code := range codes.(*schema.Set).List() {
+				statusCodes = append(statusCodes, code.(int))
+			}
+		}
+		retryPolicy = conns.NewRetryPolicy(
+			maxAttempts,
+			time.Duration(retryMap["min_delay"].(int))*time.Second,
+			time.Duration(retryMap["max_delay"].(int))*time.Second,
+			statusCodes,
+		)
+	}
 
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
-	retryCount := d.Get("max_retries").(int)
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
@@ -1762,6 +1943,21 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		os.Setenv("FUNCTION_NAMESPACE", wskNameSpace)
 	}
 
+	var transportConfig *conns.TransportConfig
+	if v, ok := d.GetOk("transport"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		transportMap := v.([]interface{})[0].(map[string]interface{})
+		transportConfig = &conns.TransportConfig{
+			MaxIdleConns:        transportMap["max_idle_conns"].(int),
+			MaxIdleConnsPerHost: transportMap["max_idle_conns_per_host"].(int),
+			MaxConnsPerHost:     transportMap["max_conns_per_host"].(int),
+			IdleConnTimeout:     time.Duration(transportMap["idle_conn_timeout"].(int)) * time.Second,
+			ProxyURL:            transportMap["proxy_url"].(string),
+			CABundleFile:        transportMap["ca_bundle_file"].(string),
+			ClientCertFile:      transportMap["client_cert_file"].(string),
+			ClientKeyFile:       transportMap["client_key_file"].(string),
+		}
+	}
+
 	config := conns.Config{
 		BluemixAPIKey:        bluemixAPIKey,
 		Region:               region,
@@ -1773,6 +1969,8 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		RetryCount:           retryCount,
 		SoftLayerEndpointURL: softlayerEndpointUrl,
 		RetryDelay:           conns.RetryAPIDelay,
+		RetryPolicy:          retryPolicy,
+		Transport:            transportConfig,
 		FunctionNameSpace:    wskNameSpace,
 		RiaasEndPoint:        riaasEndPoint,
 		IAMToken:             iamToken,
@@ -1780,7 +1978,11 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Zone:                 zone,
 		Visibility:           visibility,
 		EndpointsFile:        file,
+		PrivateEndpointsOnly: privateEndpointsOnly,
 		IAMTrustedProfileID:  iamTrustedProfileId,
+		DefaultTags:          defaultTags,
+		AuditLogPath:         auditLogPath,
+		LockTimeout:          lockTimeout,
 	}
 
 	return config.ClientSession()
@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestResourceConstraintPaths(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for name, validator := range Validator().ResourceValidatorDictionary {
+		if validator == nil || len(validator.Constraints) == 0 {
+			continue
+		}
+		resource, ok := resources[name]
+		if !ok {
+			t.Errorf("constraints are registered for %s, which is not a resource of the provider", name)
+			continue
+		}
+		if err := validator.CheckConstraintPaths(resource.Schema); err != nil {
+			t.Error(err)
+		}
+	}
+}
@@ -196,8 +196,11 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 	}
 
 	mk := fmt.Sprintf("%s.%s", *version.CatalogID, *version.OfferingID)
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmLockManager.Lock(context, meta, mk)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	valid := "valid"
 	if version.Validation.State == &valid && d.Get("revalidate_if_validated") != true {
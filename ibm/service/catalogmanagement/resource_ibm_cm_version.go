@@ -1801,8 +1801,11 @@ func resourceIBMCmVersionCreate(context context.Context, d *schema.ResourceData,
 	}
 
 	mk := fmt.Sprintf("%s.%s", d.Get("catalog_id").(string), d.Get("offering_id").(string))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmLockManager.Lock(context, meta, mk)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	getOfferingOptions := &catalogmanagementv1.GetOfferingOptions{}
 	getOfferingOptions.SetCatalogIdentifier(d.Get("catalog_id").(string))
@@ -2292,8 +2295,11 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 	}
 
 	mk := fmt.Sprintf("%s.%s", d.Get("catalog_id").(string), d.Get("offering_id").(string))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmLockManager.Lock(context, meta, mk)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	getVersionOptions := &catalogmanagementv1.GetVersionOptions{}
 	getVersionOptions.SetVersionLocID(strings.Replace(d.Id(), "/", ".", 1))
@@ -2534,8 +2540,11 @@ func resourceIBMCmVersionDelete(context context.Context, d *schema.ResourceData,
 	}
 
 	mk := fmt.Sprintf("%s.%s", d.Get("catalog_id").(string), d.Get("offering_id").(string))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmLockManager.Lock(context, meta, mk)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	deleteVersionOptions := &catalogmanagementv1.DeleteVersionOptions{}
 
@@ -31,7 +31,7 @@ func ResourceIBMCdToolchain() *schema.Resource {
 		Importer:      &schema.ResourceImporter{},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -95,6 +95,7 @@ func ResourceIBMCdToolchain() *schema.Resource {
 				Computed:    true,
 				Description: "Identity that created the toolchain.",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			"tags": &schema.Schema{
 				Type:         schema.TypeSet,
 				Optional:     true,
@@ -174,7 +175,7 @@ func resourceIBMCdToolchainCreate(context context.Context, d *schema.ResourceDat
 	d.SetId(*toolchainPost.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *toolchainPost.CRN)
 		if err != nil {
@@ -290,7 +291,7 @@ func resourceIBMCdToolchainUpdate(context context.Context, d *schema.ResourceDat
 		hasChange = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string))
 		if err != nil {
@@ -51,7 +51,7 @@ func ResourceIBMCISInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -102,6 +102,7 @@ func ResourceIBMCISInstance() *schema.Resource {
 				Description: "Arbitrary parameters to pass. Must be a JSON object",
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -237,7 +238,7 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("[ERROR] Error creating resource instance: %s %s", err, response)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -371,7 +372,7 @@ func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
 		if err != nil {
@@ -111,12 +111,12 @@ func ResourceIBMCloudant() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create:   resourceIBMCloudantCreate,
-		Read:     resourceIBMCloudantRead,
-		Update:   resourceIBMCloudantUpdate,
-		Delete:   resourcecontroller.ResourceIBMResourceInstanceDelete,
-		Exists:   resourcecontroller.ResourceIBMResourceInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		Create:        resourceIBMCloudantCreate,
+		Read:          resourceIBMCloudantRead,
+		Update:        resourceIBMCloudantUpdate,
+		DeleteContext: flex.APIErrorContextFunc(resourcecontroller.ResourceIBMResourceInstanceDelete),
+		Exists:        resourcecontroller.ResourceIBMResourceInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -126,7 +126,7 @@ func ResourceIBMCloudant() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -174,7 +174,7 @@ func resourceIBMCloudantCreate(d *schema.ResourceData, meta interface{}) error {
 		d.Set("parameters", params)
 	}
 
-	err = resourcecontroller.ResourceIBMResourceInstanceCreate(d, meta)
+	err = resourcecontroller.ResourceIBMResourceInstanceCreate(context.Background(), d, meta)
 	if err != nil {
 		return err
 	}
@@ -212,7 +212,7 @@ func resourceIBMCloudantCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIBMCloudantRead(d *schema.ResourceData, meta interface{}) error {
-	err := resourcecontroller.ResourceIBMResourceInstanceRead(d, meta)
+	err := resourcecontroller.ResourceIBMResourceInstanceRead(context.Background(), d, meta)
 	if err != nil {
 		return err
 	}
@@ -263,7 +263,7 @@ func resourceIBMCloudantUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	err = resourcecontroller.ResourceIBMResourceInstanceUpdate(d, meta)
+	err = resourcecontroller.ResourceIBMResourceInstanceUpdate(context.Background(), d, meta)
 	if err != nil {
 		return err
 	}
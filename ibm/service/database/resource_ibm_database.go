@@ -84,7 +84,8 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			resourceIBMDatabaseInstanceDiff,
-			checkV5Groups),
+			checkV5Groups,
+			validate.InvokeConstraintValidator("ibm_database")),
 
 		Importer: &schema.ResourceImporter{},
 
@@ -291,6 +292,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -923,6 +925,14 @@ func ResourceIBMICDValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			AllowedValues:              "member, analytics, bi_connector, search",
 			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "version",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              validate.CloudDataDatabaseVersion,
+			CloudDataRange:             []string{"scope:service"},
+			Optional:                   true})
 
 	ibmICDResourceValidator := validate.ResourceValidator{ResourceName: "ibm_database", Schema: validateSchema}
 	return &ibmICDResourceValidator
@@ -1150,7 +1160,7 @@ func checkMbValue(name string, limits MbLimit, divider int, diff *schema.Resourc
 }
 
 func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
-	err = flex.ResourceTagsCustomizeDiff(diff)
+	err = flex.ResourceTagsCustomizeDiff(diff, meta)
 	if err != nil {
 		return err
 	}
@@ -1525,7 +1535,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1971,7 +1981,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
 		if err != nil {
@@ -40,7 +40,7 @@ func ResourceIBMDLGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 		Schema: map[string]*schema.Schema{
@@ -505,6 +505,7 @@ func ResourceIBMDLGateway() *schema.Resource {
 				Computed:    true,
 				Description: "Gateway location long name",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			dlTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -950,7 +951,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
+	if _, ok := d.GetOk(dlTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
@@ -1327,7 +1328,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 	updateGatewayOptionsModel.ID = &ID
 	dtype := *instance.Type
 
-	if d.HasChange(dlTags) {
+	if d.HasChange(dlTags) || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
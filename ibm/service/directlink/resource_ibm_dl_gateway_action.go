@@ -36,7 +36,7 @@ func ResourceIBMDLGatewayAction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 		Schema: map[string]*schema.Schema{
@@ -426,6 +426,7 @@ func ResourceIBMDLGatewayAction() *schema.Resource {
 				Computed:    true,
 				Description: "Gateway location long name",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			dlTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -707,7 +708,7 @@ func resourceIBMdlGatewayCreateAction(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
+	if _, ok := d.GetOk(dlTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
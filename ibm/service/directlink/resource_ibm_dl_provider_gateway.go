@@ -25,7 +25,7 @@ func ResourceIBMDLProviderGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -115,6 +115,7 @@ func ResourceIBMDLProviderGateway() *schema.Resource {
 				Computed:    true,
 				Description: "The CRN (Cloud Resource Name) of this gateway",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			dlTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -232,7 +233,7 @@ func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}
 	log.Printf("[INFO] Created Direct Link Provider Gateway : %s", *gateway.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
+	if _, ok := d.GetOk(dlTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
@@ -350,7 +351,7 @@ func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}
 
 	updateGatewayOptionsModel := directLink.NewUpdateProviderGatewayOptions(ID)
 
-	if d.HasChange(dlTags) {
+	if d.HasChange(dlTags) || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -127,8 +127,11 @@ func resourceIBMDNSLinkedZoneCreate(ctx context.Context, d *schema.ResourceData,
 	createLinkedZoneOptions.SetOwnerInstanceID(ownerInstanceID)
 	createLinkedZoneOptions.SetOwnerZoneID(ownerZoneID)
 	mk := "dns_linked_zone_" + instanceID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmLockManager.Lock(ctx, meta, mk)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	resource, response, err := sess.CreateLinkedZone(createLinkedZoneOptions)
 	if err != nil {
@@ -200,8 +203,11 @@ func resourceIBMDNSLinkedZoneUpdate(ctx context.Context, d *schema.ResourceData,
 		updateLinkedZoneOptions.SetLabel(label)
 
 		mk := "dns_linked_zone_" + instanceID
-		conns.IbmMutexKV.Lock(mk)
-		defer conns.IbmMutexKV.Unlock(mk)
+		unlock, err := conns.IbmLockManager.Lock(ctx, meta, mk)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer unlock()
 
 		_, response, err := sess.UpdateLinkedZone(updateLinkedZoneOptions)
 
@@ -226,8 +232,11 @@ func resourceIBMDNSLinkedZoneDelete(ctx context.Context, d *schema.ResourceData,
 	deleteLinkedZoneOptions := sess.NewDeleteLinkedZoneOptions(instanceID, linkedDnsZoneID)
 
 	mk := "linked_dns_zone_" + instanceID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmLockManager.Lock(ctx, meta, mk)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 	response, err := sess.DeleteLinkedZone(deleteLinkedZoneOptions)
 
 	if err != nil {
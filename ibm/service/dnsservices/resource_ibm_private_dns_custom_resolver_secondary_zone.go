@@ -112,8 +112,11 @@ func resourceIBMPrivateDNSSecondaryZoneCreate(ctx context.Context, d *schema.Res
 	createSecondaryZoneOptions.SetTransferFrom(transferFrom)
 
 	mk := "private_dns_secondary_zone_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmLockManager.Lock(ctx, meta, mk)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	resource, response, err := sess.CreateSecondaryZone(createSecondaryZoneOptions)
 	if err != nil {
@@ -199,8 +202,11 @@ func resourceIBMPrivateDNSSecondaryZoneUpdate(ctx context.Context, d *schema.Res
 		updateSecondaryZoneOptions.SetEnabled(enabled)
 
 		mk := "private_dns_secondary_zone_" + instanceID + resolverID
-		conns.IbmMutexKV.Lock(mk)
-		defer conns.IbmMutexKV.Unlock(mk)
+		unlock, err := conns.IbmLockManager.Lock(ctx, meta, mk)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer unlock()
 
 		_, response, err := sess.UpdateSecondaryZone(updateSecondaryZoneOptions)
 
@@ -227,8 +233,11 @@ func resourceIBMPrivateDNSSecondaryZoneDelete(ctx context.Context, d *schema.Res
 	deleteSecondaryZoneOptions := sess.NewDeleteSecondaryZoneOptions(instanceID, resolverID, secondaryZoneID)
 
 	mk := "private_dns_secondary_zone_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmLockManager.Lock(ctx, meta, mk)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 	response, err := sess.DeleteSecondaryZone(deleteSecondaryZoneOptions)
 
 	if err != nil {
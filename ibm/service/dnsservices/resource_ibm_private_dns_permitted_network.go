@@ -109,8 +109,11 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 	vpcCRN := d.Get(pdnsVpcCRN).(string)
 	nwType := d.Get(pdnsNetworkType).(string)
 	mk := "private_dns_permitted_network_" + instanceID + zoneID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutCreate, mk)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	createPermittedNetworkOptions := sess.NewCreatePermittedNetworkOptions(instanceID, zoneID)
 	permittedNetworkCrn, err := sess.NewPermittedNetworkVpc(vpcCRN)
@@ -127,6 +130,8 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
 
+	// Read takes the lock for reading
+	unlock()
 	return resourceIBMPrivateDNSPermittedNetworkRead(d, meta)
 }
 
@@ -137,6 +142,11 @@ func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta inte
 	}
 
 	idSet := strings.Split(d.Id(), "/")
+	unlock, err := conns.IbmLockManager.RLockOperation(d, meta, schema.TimeoutRead, "private_dns_permitted_network_"+idSet[0]+idSet[1])
+	if err != nil {
+		return err
+	}
+	defer unlock()
 	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
 	response, detail, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
 
@@ -164,8 +174,11 @@ func resourceIBMPrivateDNSPermittedNetworkDelete(d *schema.ResourceData, meta in
 
 	idSet := strings.Split(d.Id(), "/")
 	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutDelete, mk)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 	deletePermittedNetworkOptions := sess.NewDeletePermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
 	_, response, err := sess.DeletePermittedNetwork(deletePermittedNetworkOptions)
 
@@ -189,8 +202,11 @@ func resourceIBMPrivateDNSPermittedNetworkExists(d *schema.ResourceData, meta in
 	}
 
 	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmLockManager.RLockOperation(d, meta, schema.TimeoutRead, mk)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
 	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
 	_, response, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
 	if err != nil {
@@ -47,7 +47,7 @@ func ResourceIBMHPCS() *schema.Resource {
 				return flex.ImmutableResourceCustomizeDiff([]string{"units", "failover_units", "location", "resource_group_id", "service"}, diff)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -96,6 +96,7 @@ func ResourceIBMHPCS() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public-and-private", "private-only"}),
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -433,7 +434,7 @@ func resourceIBMHPCSCreate(context context.Context, d *schema.ResourceData, meta
 
 	// Update Tags for this Resource using Global Tagging APIs
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -635,7 +636,7 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting HPCS instance: %s with resp code: %s", err, resp))
 	}
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
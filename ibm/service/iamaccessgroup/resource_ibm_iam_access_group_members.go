@@ -123,12 +123,12 @@ func resourceIBMIAMAccessGroupMembersCreate(context context.Context, d *schema.R
 
 	serviceids, err = FlattenServiceIds(services, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	profileids, err = FlattenProfileIds(profiles, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	members := prepareMemberAddRequest(iamAccessGroupsClient, userids, serviceids, profileids)
@@ -137,7 +137,7 @@ func resourceIBMIAMAccessGroupMembersCreate(context context.Context, d *schema.R
 	addMembersToAccessGroupOptions.SetMembers(members)
 	membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
 	if err != nil || membership == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error adding members to group(%s). API response: %s", grpID, detailResponse))
+		return flex.APIErrorDiag(err, detailResponse, "iam-access-groups", "AddMembersToAccessGroup", "ibm_iam_access_group_members", "")
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", grpID, time.Now().UTC().String()))
@@ -164,7 +164,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 	listAccessGroupMembersOptions.SetLimit(limit)
 	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
+		return flex.APIErrorDiag(err, detailedResponse, "iam-access-groups", "ListAccessGroupMembers", "ibm_iam_access_group_members", d.Id())
 	}
 	allMembers := members.Members
 	totalMembers := flex.IntValue(members.TotalCount)
@@ -173,7 +173,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 		listAccessGroupMembersOptions.SetOffset(offset)
 		members, detailedResponse, err = iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
+			return flex.APIErrorDiag(err, detailedResponse, "iam-access-groups", "ListAccessGroupMembers", "ibm_iam_access_group_members", d.Id())
 		}
 		allMembers = append(allMembers, members.Members...)
 	}
@@ -194,7 +194,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 	client := userManagement.UserInvite()
 	res, err := client.ListUsers(accountID)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.APIErrorDiag(err, nil, "user-management", "ListUsers", "ibm_iam_access_group_members", d.Id())
 	}
 
 	iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
@@ -216,7 +216,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 
 		serviceIDs, resp, err := iamClient.ListServiceIds(&listServiceIDOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Service Ids %s %s", err, resp))
+			return flex.APIErrorDiag(err, resp, "iam-identity", "ListServiceIds", "ibm_iam_access_group_members", d.Id())
 		}
 		start = flex.GetNextIAM(serviceIDs.Next)
 		allrecs = append(allrecs, serviceIDs.Serviceids...)
@@ -239,7 +239,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 
 		profileIDs, resp, err := iamClient.ListProfiles(&listProfilesOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Trusted Profiles %s %s", err, resp))
+			return flex.APIErrorDiag(err, resp, "iam-identity", "ListProfiles", "ibm_iam_access_group_members", d.Id())
 		}
 		profileStart = flex.GetNextIAM(profileIDs.Next)
 		allprofiles = append(allprofiles, profileIDs.Profiles...)
@@ -313,12 +313,12 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 
 		serviceids, err = FlattenServiceIds(addServiceids, meta)
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.DiagFromErr(err)
 		}
 
 		profileids, err = FlattenProfileIds(addProfileids, meta)
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.DiagFromErr(err)
 		}
 
 		members := prepareMemberAddRequest(iamAccessGroupsClient, userids, serviceids, profileids)
@@ -327,7 +327,7 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 		addMembersToAccessGroupOptions.SetMembers(members)
 		membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
 		if err != nil || membership == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating members to group(%s). API response: %s", grpID, detailResponse))
+			return flex.APIErrorDiag(err, detailResponse, "iam-access-groups", "AddMembersToAccessGroup", "ibm_iam_access_group_members", d.Id())
 		}
 
 	}
@@ -342,9 +342,9 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 				return diag.FromErr(err)
 			}
 			removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, ibmUniqueId)
-			_, err = iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
+			response, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
 			if err != nil {
-				return diag.FromErr(err)
+				return flex.APIErrorDiag(err, response, "iam-access-groups", "RemoveMemberFromAccessGroup", "ibm_iam_access_group_members", d.Id())
 			}
 
 		}
@@ -355,12 +355,12 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 			}
 			serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 			if err != nil || serviceID == nil {
-				return diag.FromErr(fmt.Errorf("ERROR] Error Getting Service Ids %s %s", err, resp))
+				return flex.APIErrorDiag(err, resp, "iam-identity", "GetServiceID", "ibm_iam_access_group_members", d.Id())
 			}
 			removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, *serviceID.IamID)
 			detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error removing members to group(%s). API Response: %s", grpID, detailResponse))
+				return flex.APIErrorDiag(err, detailResponse, "iam-access-groups", "RemoveMemberFromAccessGroup", "ibm_iam_access_group_members", d.Id())
 			}
 
 		}
@@ -371,12 +371,12 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 			}
 			profileID, resp, err := iamClient.GetProfile(&getProfileOptions)
 			if err != nil || profileID == nil {
-				return diag.FromErr(fmt.Errorf("ERROR] Error Getting Profile Ids %s %s", err, resp))
+				return flex.APIErrorDiag(err, resp, "iam-identity", "GetProfile", "ibm_iam_access_group_members", d.Id())
 			}
 			removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, *profileID.IamID)
 			detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error removing members to group(%s). API Response: %s", grpID, detailResponse))
+				return flex.APIErrorDiag(err, detailResponse, "iam-access-groups", "RemoveMemberFromAccessGroup", "ibm_iam_access_group_members", d.Id())
 			}
 
 		}
@@ -414,9 +414,9 @@ func resourceIBMIAMAccessGroupMembersDelete(context context.Context, d *schema.R
 		}
 
 		removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, ibmUniqueID)
-		_, err = iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
+		response, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.APIErrorDiag(err, response, "iam-access-groups", "RemoveMemberFromAccessGroup", "ibm_iam_access_group_members", d.Id())
 		}
 
 	}
@@ -426,16 +426,16 @@ func resourceIBMIAMAccessGroupMembersDelete(context context.Context, d *schema.R
 	for _, id := range services {
 		serviceID, err := getServiceID(id, meta)
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.DiagFromErr(err)
 		}
 
 		removeMembersFromAccessGroupOptions := &iamaccessgroupsv2.RemoveMemberFromAccessGroupOptions{
 			AccessGroupID: &grpID,
 			IamID:         serviceID.IamID,
 		}
-		_, err = iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
+		response, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.APIErrorDiag(err, response, "iam-access-groups", "RemoveMemberFromAccessGroup", "ibm_iam_access_group_members", d.Id())
 		}
 	}
 
@@ -444,16 +444,16 @@ func resourceIBMIAMAccessGroupMembersDelete(context context.Context, d *schema.R
 	for _, id := range profiles {
 		profileID, err := getProfileID(id, meta)
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.DiagFromErr(err)
 		}
 
 		removeMembersFromAccessGroupOptions := &iamaccessgroupsv2.RemoveMemberFromAccessGroupOptions{
 			AccessGroupID: &grpID,
 			IamID:         profileID.IamID,
 		}
-		_, err = iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
+		response, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.APIErrorDiag(err, response, "iam-access-groups", "RemoveMemberFromAccessGroup", "ibm_iam_access_group_members", d.Id())
 		}
 	}
 
@@ -508,7 +508,7 @@ func getServiceID(id string, meta interface{}) (iamidentityv1.ServiceID, error)
 	}
 	serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 	if err != nil || serviceID == nil {
-		return serviceids, fmt.Errorf("ERROR] Error Getting Service Ids %s %s", err, resp)
+		return serviceids, flex.NewAPIError(err, resp, "iam-identity", "GetServiceID", "ibm_iam_access_group_members", "")
 	}
 	return *serviceID, nil
 }
@@ -548,7 +548,7 @@ func getProfileID(id string, meta interface{}) (iamidentityv1.TrustedProfile, er
 	}
 	profileID, resp, err := iamClient.GetProfile(&getProfileOptions)
 	if err != nil || profileID == nil {
-		return profileids, fmt.Errorf("ERROR] Error Getting Profile Ids %s %s", err, resp)
+		return profileids, flex.NewAPIError(err, resp, "iam-identity", "GetProfile", "ibm_iam_access_group_members", "")
 	}
 	return *profileID, nil
 }
@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
@@ -127,6 +129,19 @@ func DataSourceIBMContainerClusterConfig() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"in_memory": {
+				Description:   "If set to true, the config is downloaded and read in memory and returned in config_yaml, nothing is written to disk",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"config_dir", "network"},
+			},
+			"config_yaml": {
+				Description: "The content of the kubernetes config, with the certificates embedded. Only set if in_memory is true",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
 		},
 	}
 }
@@ -158,9 +173,16 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	network := d.Get("network").(bool)
 	endpointType := d.Get("endpoint_type").(string)
 
+	if d.Get("in_memory").(bool) {
+		return dataSourceIBMContainerClusterConfigReadInMemory(d, meta)
+	}
+
 	clusterId := "Cluster_Config_" + name
-	conns.IbmMutexKV.Lock(clusterId)
-	defer conns.IbmMutexKV.Unlock(clusterId)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutRead, clusterId)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if len(configDir) == 0 {
 		configDir, err = homedir.Dir()
@@ -256,3 +278,68 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	d.Set("config_dir", configDir)
 	return nil
 }
+
+func dataSourceIBMContainerClusterConfigReadInMemory(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("cluster_name_id").(string)
+	admin := d.Get("admin").(bool)
+	endpointType := d.Get("endpoint_type").(string)
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	var config *clientcmdapi.Config
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		config, err = getClusterKubeConfig(meta, name, admin, endpointType, targetEnv)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+			if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
+				return resource.RetryableError(err)
+			}
+			if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
+				// Intermittent error resulting from synchronisation delay
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		config, err = getClusterKubeConfig(meta, name, admin, endpointType, targetEnv)
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error downloading the cluster config [%s]: %s", name, err)
+	}
+
+	content, err := clientcmd.Write(*config)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error writing the cluster config [%s]: %s", name, err)
+	}
+	var host, caCertificate, token, adminCertificate, adminKey string
+	if kubeContext, ok := config.Contexts[config.CurrentContext]; ok {
+		if cluster, ok := config.Clusters[kubeContext.Cluster]; ok {
+			host = cluster.Server
+			caCertificate = string(cluster.CertificateAuthorityData)
+		}
+		if user, ok := config.AuthInfos[kubeContext.AuthInfo]; ok {
+			token = user.Token
+			if user.AuthProvider != nil && token == "" {
+				token = user.AuthProvider.Config["id-token"]
+			}
+			adminCertificate = string(user.ClientCertificateData)
+			adminKey = string(user.ClientKeyData)
+		}
+	}
+
+	d.SetId(name)
+	d.Set("config_yaml", string(content))
+	d.Set("host", host)
+	d.Set("ca_certificate", caCertificate)
+	d.Set("token", token)
+	d.Set("admin_certificate", adminCertificate)
+	d.Set("admin_key", adminKey)
+	d.Set("config_file_path", "")
+	d.Set("calico_config_file_path", "")
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	gohttp "net/http"
+	"path"
+	"strings"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// containerRESTClient is the REST client embedded in the container service
+// client, used for the calls the service client has no method for.
+type containerRESTClient interface {
+	Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+}
+
+// openshiftKubeConfigTokenFetcher adds the OpenShift token of the user to a
+// kubeconfig, as the cluster config download does for OpenShift clusters.
+type openshiftKubeConfigTokenFetcher interface {
+	FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *v2.ClusterInfo, skipSSLVerification bool) ([]byte, error)
+}
+
+// getClusterKubeConfig downloads the kubeconfig of a cluster and loads it with
+// the certificates it references embedded. Nothing is written to disk.
+func getClusterKubeConfig(meta interface{}, cluster string, admin bool, endpointType string, target v2.ClusterTargetHeader) (*clientcmdapi.Config, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	restClient, ok := csClient.(containerRESTClient)
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] The container service client can't download the cluster config [%s]", cluster)
+	}
+	clusterInfo, err := csClient.Clusters().GetCluster(cluster, target)
+	if err != nil {
+		return nil, err
+	}
+
+	postBody := map[string]interface{}{
+		"cluster": cluster,
+		"format":  "zip",
+	}
+	if admin {
+		postBody["admin"] = true
+	}
+	if clusterInfo.Provider == "satellite" {
+		postBody["endpointType"] = "link"
+		postBody["admin"] = true
+	} else if endpointType != "" {
+		postBody["endpointType"] = endpointType
+	}
+	var archive bytes.Buffer
+	if _, err := restClient.Post("/v2/applyRBACAndGetKubeconfig", postBody, &archive, target.ToMap()); err != nil {
+		return nil, err
+	}
+
+	kubeconfig, files, err := readClusterKubeConfigArchive(archive.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading the cluster config [%s]: %s", cluster, err)
+	}
+	if clusterInfo.Type == "openshift" && clusterInfo.Provider != "satellite" {
+		fetcher, ok := csClient.Clusters().(openshiftKubeConfigTokenFetcher)
+		if !ok {
+			return nil, fmt.Errorf("[ERROR] The container service client can't get the OpenShift token of the cluster config [%s]", cluster)
+		}
+		if kubeconfig, err = fetcher.FetchOCTokenForKubeConfig(kubeconfig, clusterInfo, clusterInfo.IsStagingSatelliteCluster()); err != nil {
+			return nil, err
+		}
+	}
+	config, err := loadClusterKubeConfig(kubeconfig, files)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing the cluster config [%s]: %s", cluster, err)
+	}
+	return config, nil
+}
+
+// readClusterKubeConfigArchive returns the kubeconfig of the zip archive of a
+// cluster config, and the other files of the archive by name.
+func readClusterKubeConfigArchive(archive []byte) ([]byte, map[string][]byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, nil, err
+	}
+	var kubeconfig []byte
+	files := make(map[string][]byte)
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		content, err := readZipFile(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		name := path.Base(file.Name)
+		if strings.HasSuffix(name, ".yml") || strings.HasSuffix(name, ".yaml") {
+			kubeconfig = content
+			continue
+		}
+		files[name] = content
+	}
+	if kubeconfig == nil {
+		return nil, nil, fmt.Errorf("no kubeconfig in the archive")
+	}
+	return kubeconfig, files, nil
+}
+
+func readZipFile(file *zip.File) ([]byte, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// loadClusterKubeConfig parses a kubeconfig and embeds the certificates and
+// keys it references from files, by file name.
+func loadClusterKubeConfig(kubeconfig []byte, files map[string][]byte) (*clientcmdapi.Config, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	embed := func(file *string, data *[]byte) error {
+		if *file == "" {
+			return nil
+		}
+		content, ok := files[path.Base(*file)]
+		if !ok {
+			return fmt.Errorf("%s is not in the archive", *file)
+		}
+		*data = content
+		*file = ""
+		return nil
+	}
+	for _, cluster := range config.Clusters {
+		if err := embed(&cluster.CertificateAuthority, &cluster.CertificateAuthorityData); err != nil {
+			return nil, err
+		}
+	}
+	for _, user := range config.AuthInfos {
+		if err := embed(&user.ClientCertificate, &user.ClientCertificateData); err != nil {
+			return nil, err
+		}
+		if err := embed(&user.ClientKey, &user.ClientKeyData); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// getClusterKubeClient returns a Kubernetes client authenticated with the
+// admin certificate of the cluster.
+func getClusterKubeClient(meta interface{}, cluster string, target v2.ClusterTargetHeader) (*kubernetes.Clientset, error) {
+	config, err := getClusterKubeConfig(meta, cluster, true, "", target)
+	if err != nil {
+		return nil, err
+	}
+	restConfig, err := clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid kubeconfig for cluster [%s]: %s", cluster, err)
+	}
+	return kubernetes.NewForConfig(restConfig)
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const testClusterKubeConfig = `apiVersion: v1
+kind: Config
+current-context: mycluster/admin
+clusters:
+- name: mycluster
+  cluster:
+    certificate-authority: ca-dal10-mycluster.pem
+    server: https://c100.us-south.containers.cloud.ibm.com:30000
+users:
+- name: admin
+  user:
+    client-certificate: admin.pem
+    client-key: admin-key.pem
+contexts:
+- name: mycluster/admin
+  context:
+    cluster: mycluster
+    user: admin
+`
+
+func testClusterConfigArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var archive bytes.Buffer
+	w := zip.NewWriter(&archive)
+	for name, content := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return archive.Bytes()
+}
+
+func TestClusterKubeConfigFromArchive(t *testing.T) {
+	archive := testClusterConfigArchive(t, map[string]string{
+		"kubeConfig-mycluster/":                                "",
+		"kubeConfig-mycluster/kube-config-dal10-mycluster.yml": testClusterKubeConfig,
+		"kubeConfig-mycluster/ca-dal10-mycluster.pem":          "ca",
+		"kubeConfig-mycluster/admin.pem":                       "cert",
+		"kubeConfig-mycluster/admin-key.pem":                   "key",
+	})
+	kubeconfig, files, err := readClusterKubeConfigArchive(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := loadClusterKubeConfig(kubeconfig, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cluster := config.Clusters["mycluster"]
+	if cluster == nil || string(cluster.CertificateAuthorityData) != "ca" || cluster.CertificateAuthority != "" {
+		t.Errorf("expected the CA certificate to be embedded, got %+v", cluster)
+	}
+	user := config.AuthInfos["admin"]
+	if user == nil || string(user.ClientCertificateData) != "cert" || string(user.ClientKeyData) != "key" || user.ClientCertificate != "" || user.ClientKey != "" {
+		t.Errorf("expected the admin certificate and key to be embedded, got %+v", user)
+	}
+	if config.CurrentContext != "mycluster/admin" {
+		t.Errorf("expected the current context mycluster/admin, got %q", config.CurrentContext)
+	}
+}
+
+func TestClusterKubeConfigFromArchiveErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		archive []byte
+		err     string
+	}{
+		{name: "not a zip", archive: []byte("not a zip"), err: "not a valid zip file"},
+		{name: "no kubeconfig", archive: testClusterConfigArchive(t, map[string]string{"admin.pem": "cert"}), err: "no kubeconfig"},
+		{
+			name:    "a missing certificate",
+			archive: testClusterConfigArchive(t, map[string]string{"kube-config.yaml": testClusterKubeConfig, "admin.pem": "cert", "admin-key.pem": "key"}),
+			err:     "ca-dal10-mycluster.pem is not in the archive",
+		},
+	}
+	for _, c := range cases {
+		kubeconfig, files, err := readClusterKubeConfigArchive(c.archive)
+		if err == nil {
+			_, err = loadClusterKubeConfig(kubeconfig, files)
+		}
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%s: expected an error containing %q, got %v", c.name, c.err, err)
+		}
+	}
+}
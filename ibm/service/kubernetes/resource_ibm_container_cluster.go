@@ -74,8 +74,9 @@ func ResourceIBMContainerCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
+			validate.InvokeConstraintValidator("ibm_container_cluster"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -444,6 +445,7 @@ func ResourceIBMContainerCluster() *schema.Resource {
 				Optional:   true,
 				Deprecated: "This field is deprecated",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			"tags": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -647,7 +649,14 @@ func ResourceIBMContainerClusterValidator() *validate.ResourceValidator {
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Required:                   true,
-			AllowedValues:              tainteffects})
+			AllowedValues:              tainteffects},
+		validate.ValidateSchema{
+			Identifier:                 "machine_type",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			CloudDataType:              validate.CloudDataKubernetesFlavor,
+			CloudDataRange:             []string{"scope:datacenter"}})
 
 	ibmContainerClusterResourceValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster", Schema: validateSchema}
 	return &ibmContainerClusterResourceValidator
@@ -1317,7 +1326,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange(flex.ResourceTagsAll) || v != "" {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := clusterAPI.Find(clusterID, targetEnv)
 		if err != nil {
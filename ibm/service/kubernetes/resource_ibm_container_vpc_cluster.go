@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -46,8 +48,12 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMContainerVpcClusterWorkerUpdateCustomizeDiff(diff)
+			},
+			validate.InvokeConstraintValidator("ibm_container_vpc_cluster"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +173,74 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Wait for worker node to update during kube version update.",
 			},
 
+			"update_strategy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Controls how the worker nodes are replaced when their kube version is updated",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_unavailable": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "1",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|([1-9][0-9]?|100)%)$`), "must be a number of worker nodes or a percentage, for example 2 or 25%"),
+							Description:  "Number or percentage of the worker nodes of a worker pool that are replaced at the same time",
+						},
+						"pool_order": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Names of the worker pools in the order they are updated. Worker pools that are not listed are updated afterwards",
+						},
+						"pause_between_batches": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Number of seconds to wait after a batch of worker nodes is replaced before the next batch starts",
+						},
+						"min_ready_workers": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Aborts the update when fewer worker nodes of the cluster than this number are in normal state before a batch starts",
+						},
+					},
+				},
+			},
+
+			"worker_update_progress": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Progress of the last worker update done with update_strategy",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the worker update, in_progress or completed",
+						},
+						"target_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Kube version of the cluster master the worker nodes are updated to",
+						},
+						"completed_pools": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Names of the worker pools whose worker nodes are all updated",
+						},
+						"updated_workers": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of worker nodes replaced so far",
+						},
+					},
+				},
+			},
+
 			"service_subnet": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -249,6 +323,7 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Boolean value true if Public service endpoint to be disabled",
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			"tags": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -472,7 +547,13 @@ func ResourceIBMContainerVpcClusterValidator() *validate.ResourceValidator {
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Required:                   true,
-			AllowedValues:              tainteffects})
+			AllowedValues:              tainteffects},
+		validate.ValidateSchema{
+			Identifier:                 "flavor",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              validate.CloudDataKubernetesFlavor})
 
 	ibmContainerVpcClusteresourceValidator := validate.ResourceValidator{ResourceName: "ibm_container_vpc_cluster", Schema: validateSchema}
 	return &ibmContainerVpcClusteresourceValidator
@@ -648,7 +729,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 	clusterID := d.Id()
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange(flex.ResourceTagsAll) || v != "" {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
@@ -697,7 +778,9 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 	}
 
-	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || d.HasChange("retry_patch_version")) && !d.IsNewResource() {
+	oldProgress, _ := d.GetChange("worker_update_progress")
+	resumeWorkerUpdate := isVpcClusterWorkerUpdateInProgress(oldProgress)
+	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || d.HasChange("retry_patch_version") || resumeWorkerUpdate) && !d.IsNewResource() {
 
 		if d.HasChange("kube_version") {
 			ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
@@ -749,7 +832,14 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		workersInfo := make(map[string]int)
 
 		updateAllWorkers := d.Get("update_all_workers").(bool)
-		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
+		_, rolling := d.GetOk("update_strategy")
+		if rolling && (updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") || resumeWorkerUpdate) {
+			err := updateVpcClusterWorkersInBatches(d, meta, targetEnv, cls.MasterKubeVersion, oldProgress, resumeWorkerUpdate)
+			if err != nil {
+				d.Set("patch_version", nil)
+				return err
+			}
+		} else if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
 
 			// patchVersion := d.Get("patch_version").(string)
 			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
@@ -1541,3 +1631,263 @@ func getNewWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.Clust
 	}
 	return "", -1, fmt.Errorf("[ERROR] no new node found")
 }
+
+// waitForVpcClusterPoolSuccessors waits for the replaced workers of a worker
+// pool to be deleted and for as many new workers to be created in the pool,
+// and returns the IDs of the new workers.
+func waitForVpcClusterPoolSuccessors(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, pool string, replaced []v2.Worker, existing map[string]bool) ([]string, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterID := d.Id()
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"replacing"},
+		Target:  []string{"replaced"},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+			if err != nil {
+				return workers, "", fmt.Errorf("[ERROR] Error in retriving the list of worker nodes")
+			}
+			if successors, ok := vpcClusterPoolSuccessors(workers, pool, replaced, existing); ok {
+				return successors, "replaced", nil
+			}
+			return workers, "replacing", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	successors, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+	return successors.([]string), nil
+}
+
+// vpcClusterPoolSuccessors returns the workers of the pool that are not in
+// existing, and whether the replaced workers are deleted and have as many
+// successors. The workers of the other pools are ignored, so are the workers
+// the autoscaler or a resize adds or removes meanwhile beyond the successors.
+func vpcClusterPoolSuccessors(workers []v2.Worker, pool string, replaced []v2.Worker, existing map[string]bool) ([]string, bool) {
+	pending := make(map[string]bool, len(replaced))
+	for _, worker := range replaced {
+		pending[worker.ID] = true
+	}
+	successors := []string{}
+	for _, worker := range workers {
+		if worker.LifeCycle.ActualState == "deleted" {
+			continue
+		}
+		if pending[worker.ID] {
+			return nil, false
+		}
+		if worker.PoolName == pool && !existing[worker.ID] {
+			successors = append(successors, worker.ID)
+		}
+	}
+	return successors, len(successors) >= len(replaced)
+}
+
+const (
+	workerUpdateInProgress = "in_progress"
+	workerUpdateCompleted  = "completed"
+)
+
+// resourceIBMContainerVpcClusterWorkerUpdateCustomizeDiff plans an update when
+// a previous apply stopped in the middle of a rolling worker update, so that
+// the next apply resumes it.
+func resourceIBMContainerVpcClusterWorkerUpdateCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if _, ok := diff.GetOk("update_strategy"); !ok {
+		return nil
+	}
+	oldProgress, _ := diff.GetChange("worker_update_progress")
+	if isVpcClusterWorkerUpdateInProgress(oldProgress) {
+		return diff.SetNewComputed("worker_update_progress")
+	}
+	return nil
+}
+
+func isVpcClusterWorkerUpdateInProgress(progress interface{}) bool {
+	progressList, ok := progress.([]interface{})
+	if !ok || len(progressList) == 0 || progressList[0] == nil {
+		return false
+	}
+	return progressList[0].(map[string]interface{})["status"].(string) == workerUpdateInProgress
+}
+
+// vpcClusterMaxUnavailable returns the number of worker nodes of a pool of
+// poolSize workers that are replaced at once, at least one.
+func vpcClusterMaxUnavailable(maxUnavailable string, poolSize int) int {
+	count := 1
+	if strings.HasSuffix(maxUnavailable, "%") {
+		if percent, err := strconv.Atoi(strings.TrimSuffix(maxUnavailable, "%")); err == nil {
+			count = poolSize * percent / 100
+		}
+	} else if n, err := strconv.Atoi(maxUnavailable); err == nil {
+		count = n
+	}
+	if count < 1 {
+		count = 1
+	}
+	return count
+}
+
+// orderVpcClusterWorkerPools groups the workers by worker pool, the pools of
+// poolOrder first and the other ones in the order they are listed.
+func orderVpcClusterWorkerPools(workers []v2.Worker, poolOrder []interface{}) ([]string, map[string][]v2.Worker) {
+	pools := []string{}
+	poolWorkers := make(map[string][]v2.Worker)
+	for _, worker := range workers {
+		if _, ok := poolWorkers[worker.PoolName]; !ok {
+			pools = append(pools, worker.PoolName)
+		}
+		poolWorkers[worker.PoolName] = append(poolWorkers[worker.PoolName], worker)
+	}
+
+	ordered := []string{}
+	seen := make(map[string]bool)
+	for _, pool := range poolOrder {
+		name := pool.(string)
+		if _, ok := poolWorkers[name]; ok && !seen[name] {
+			ordered = append(ordered, name)
+			seen[name] = true
+		}
+	}
+	for _, name := range pools {
+		if !seen[name] {
+			ordered = append(ordered, name)
+		}
+	}
+	return ordered, poolWorkers
+}
+
+// updateVpcClusterWorkersInBatches replaces the outdated worker nodes pool by
+// pool, max_unavailable at a time, and records the progress in
+// worker_update_progress. Worker nodes that are already at their target
+// version are skipped, which lets a retried apply resume the update.
+func updateVpcClusterWorkersInBatches(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, masterVersion string, oldProgress interface{}, resume bool) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	clusterID := d.Id()
+	strategy := d.Get("update_strategy").([]interface{})[0].(map[string]interface{})
+	maxUnavailable := strategy["max_unavailable"].(string)
+	pause := time.Duration(strategy["pause_between_batches"].(int)) * time.Second
+	minReadyWorkers := strategy["min_ready_workers"].(int)
+
+	progress := map[string]interface{}{
+		"status":          workerUpdateInProgress,
+		"target_version":  masterVersion,
+		"completed_pools": []interface{}{},
+		"updated_workers": 0,
+	}
+	if resume {
+		last := oldProgress.([]interface{})[0].(map[string]interface{})
+		if last["target_version"].(string) == masterVersion {
+			progress["completed_pools"] = last["completed_pools"].([]interface{})
+			progress["updated_workers"] = last["updated_workers"].(int)
+		}
+	}
+	setProgress := func() {
+		if err := d.Set("worker_update_progress", []interface{}{progress}); err != nil {
+			log.Printf("[ERROR] Error setting worker_update_progress: %s", err)
+		}
+	}
+	setProgress()
+
+	workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+	pools, poolWorkers := orderVpcClusterWorkerPools(workers, strategy["pool_order"].([]interface{}))
+
+	firstBatch := true
+	for _, pool := range pools {
+		outdated := []v2.Worker{}
+		for _, worker := range poolWorkers[pool] {
+			if worker.KubeVersion.Actual != worker.KubeVersion.Target {
+				outdated = append(outdated, worker)
+			}
+		}
+		batchSize := vpcClusterMaxUnavailable(maxUnavailable, len(poolWorkers[pool]))
+
+		for start := 0; start < len(outdated); start += batchSize {
+			end := start + batchSize
+			if end > len(outdated) {
+				end = len(outdated)
+			}
+			batch := outdated[start:end]
+
+			if !firstBatch && pause > 0 {
+				log.Printf("[INFO] Pausing %s before the next batch of workers of cluster (%s)", pause, clusterID)
+				time.Sleep(pause)
+			}
+			firstBatch = false
+
+			current, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+			}
+			if minReadyWorkers > 0 {
+				readyWorkers := 0
+				for _, worker := range current {
+					if worker.Health.State == normal {
+						readyWorkers++
+					}
+				}
+				if readyWorkers < minReadyWorkers {
+					return fmt.Errorf("[ERROR] Aborting the update of the workers of cluster (%s): %d workers are ready, fewer than min_ready_workers %d", clusterID, readyWorkers, minReadyWorkers)
+				}
+			}
+			existing := make(map[string]bool)
+			for _, worker := range current {
+				existing[worker.ID] = true
+			}
+
+			log.Printf("[INFO] Replacing %d workers of worker pool %s of cluster (%s)", len(batch), pool, clusterID)
+			for _, worker := range batch {
+				_, err := csClient.Workers().ReplaceWokerNode(clusterID, worker.ID, targetEnv)
+				// As API returns http response 204 NO CONTENT, error raised will be exempted.
+				if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+					return fmt.Errorf("[ERROR] Error replacing the worker node %s from the cluster: %s", worker.ID, err)
+				}
+			}
+			successors, err := waitForVpcClusterPoolSuccessors(d, meta, targetEnv, pool, batch, existing)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Failed to replace the workers of worker pool %s: %s", pool, err)
+			}
+			for _, workerID := range successors {
+				if _, err := WaitForVpcClusterWokersVersionUpdate(d, meta, targetEnv, masterVersion, workerID); err != nil {
+					return fmt.Errorf(
+						"[ERROR] Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), err)
+				}
+			}
+
+			progress["updated_workers"] = progress["updated_workers"].(int) + len(batch)
+			setProgress()
+		}
+
+		completedPools := progress["completed_pools"].([]interface{})
+		alreadyCompleted := false
+		for _, completed := range completedPools {
+			if completed.(string) == pool {
+				alreadyCompleted = true
+			}
+		}
+		if !alreadyCompleted {
+			progress["completed_pools"] = append(completedPools, pool)
+		}
+		setProgress()
+	}
+
+	progress["status"] = workerUpdateCompleted
+	setProgress()
+	return nil
+}
@@ -152,6 +152,41 @@ func TestAccIBMContainerVpcClusterDedicatedHost(t *testing.T) {
 	)
 }
 
+func TestAccIBMContainerVpcClusterUpdateStrategy(t *testing.T) {
+	name := fmt.Sprintf("tf-vpc-cluster-%d", acctest.RandIntRange(10, 100))
+	var conf *v2.ClusterInfo
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerVpcClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerVpcClusterUpdateStrategy(name, acc.KubeVersion),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "update_strategy.0.max_unavailable", "50%"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "worker_update_progress.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerVpcClusterUpdateStrategy(name, acc.KubeUpdateVersion),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "worker_update_progress.0.status", "completed"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "worker_update_progress.0.completed_pools.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "worker_update_progress.0.updated_workers", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMContainerVpcClusterDestroy(s *terraform.State) error {
 	csClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -339,6 +374,45 @@ resource "ibm_container_vpc_cluster" "cluster" {
   }`, name)
 }
 
+func testAccCheckIBMContainerVpcClusterUpdateStrategy(name, kubeVersion string) string {
+	return fmt.Sprintf(`
+provider "ibm" {
+	region ="eu-de"
+}
+data "ibm_resource_group" "resource_group" {
+	is_default = "true"
+}
+resource "ibm_is_vpc" "vpc" {
+	name = "%[1]s"
+}
+resource "ibm_is_subnet" "subnet" {
+	name                     = "%[1]s"
+	vpc                      = ibm_is_vpc.vpc.id
+	zone                     = "eu-de-1"
+	total_ipv4_address_count = 256
+}
+resource "ibm_container_vpc_cluster" "cluster" {
+	name               = "%[1]s"
+	vpc_id             = ibm_is_vpc.vpc.id
+	flavor             = "cx2.2x4"
+	worker_count       = 2
+	kube_version       = "%[2]s"
+	update_all_workers = true
+	wait_till          = "OneWorkerNodeReady"
+	resource_group_id  = data.ibm_resource_group.resource_group.id
+	zones {
+		subnet_id = ibm_is_subnet.subnet.id
+		name      = "eu-de-1"
+	}
+	update_strategy {
+		max_unavailable       = "50%%"
+		pool_order            = ["default"]
+		pause_between_batches = 60
+		min_ready_workers     = 1
+	}
+}`, name, kubeVersion)
+}
+
 func testAccCheckIBMContainerOcpClusterBasic(name, openshiftFlavour, openShiftworkerCount, operatingSystem string) string {
 	return fmt.Sprintf(`
 data "ibm_resource_instance" "cos_instance" {
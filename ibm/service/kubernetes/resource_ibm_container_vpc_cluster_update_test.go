@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+)
+
+func TestVpcClusterMaxUnavailable(t *testing.T) {
+	cases := []struct {
+		maxUnavailable string
+		poolSize       int
+		expected       int
+	}{
+		{maxUnavailable: "1", poolSize: 3, expected: 1},
+		{maxUnavailable: "2", poolSize: 3, expected: 2},
+		{maxUnavailable: "5", poolSize: 3, expected: 5},
+		{maxUnavailable: "0", poolSize: 3, expected: 1},
+		{maxUnavailable: "25%", poolSize: 8, expected: 2},
+		{maxUnavailable: "25%", poolSize: 10, expected: 2},
+		{maxUnavailable: "25%", poolSize: 3, expected: 1},
+		{maxUnavailable: "100%", poolSize: 4, expected: 4},
+		{maxUnavailable: "0%", poolSize: 4, expected: 1},
+		{maxUnavailable: "", poolSize: 4, expected: 1},
+		{maxUnavailable: "all", poolSize: 4, expected: 1},
+		{maxUnavailable: "half%", poolSize: 4, expected: 1},
+	}
+	for _, c := range cases {
+		if count := vpcClusterMaxUnavailable(c.maxUnavailable, c.poolSize); count != c.expected {
+			t.Errorf("max_unavailable %q of %d workers: expected %d, got %d", c.maxUnavailable, c.poolSize, c.expected, count)
+		}
+	}
+}
+
+func TestOrderVpcClusterWorkerPools(t *testing.T) {
+	workers := []v2.Worker{
+		{ID: "w1", PoolName: "default"},
+		{ID: "w2", PoolName: "edge"},
+		{ID: "w3", PoolName: "default"},
+		{ID: "w4", PoolName: "gpu"},
+		{ID: "w5", PoolName: "edge"},
+	}
+	cases := []struct {
+		name      string
+		poolOrder []interface{}
+		expected  []string
+	}{
+		{name: "listed order", poolOrder: []interface{}{}, expected: []string{"default", "edge", "gpu"}},
+		{name: "every pool ordered", poolOrder: []interface{}{"gpu", "edge", "default"}, expected: []string{"gpu", "edge", "default"}},
+		{name: "unordered pools last", poolOrder: []interface{}{"gpu"}, expected: []string{"gpu", "default", "edge"}},
+		{name: "unknown pools ignored", poolOrder: []interface{}{"spot", "edge"}, expected: []string{"edge", "default", "gpu"}},
+		{name: "duplicates ignored", poolOrder: []interface{}{"edge", "edge", "default"}, expected: []string{"edge", "default", "gpu"}},
+	}
+	for _, c := range cases {
+		pools, poolWorkers := orderVpcClusterWorkerPools(workers, c.poolOrder)
+		if !reflect.DeepEqual(pools, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, pools)
+		}
+		if len(poolWorkers["default"]) != 2 || poolWorkers["default"][0].ID != "w1" || poolWorkers["default"][1].ID != "w3" {
+			t.Errorf("%s: expected the workers w1 and w3 in the default pool, got %v", c.name, poolWorkers["default"])
+		}
+	}
+}
+
+func TestVpcClusterPoolSuccessors(t *testing.T) {
+	worker := func(id, pool string) v2.Worker {
+		return v2.Worker{ID: id, PoolName: pool, LifeCycle: v2.WorkerLifeCycle{ActualState: "deployed"}}
+	}
+	deleted := func(w v2.Worker) v2.Worker {
+		w.LifeCycle.ActualState = "deleted"
+		return w
+	}
+	replaced := []v2.Worker{worker("w1", "default"), worker("w2", "default")}
+	existing := map[string]bool{"w1": true, "w2": true, "w3": true, "e1": true}
+
+	cases := []struct {
+		name       string
+		workers    []v2.Worker
+		successors []string
+		done       bool
+	}{
+		{
+			name:       "replaced workers not deleted yet",
+			workers:    []v2.Worker{worker("w1", "default"), worker("w2", "default"), worker("w3", "default"), worker("e1", "edge")},
+			successors: nil,
+		},
+		{
+			name:       "one replaced worker still deleting",
+			workers:    []v2.Worker{worker("w2", "default"), worker("w3", "default"), worker("n1", "default"), worker("n2", "default")},
+			successors: nil,
+		},
+		{
+			name:       "replaced workers deleted without successors",
+			workers:    []v2.Worker{worker("w3", "default"), worker("e1", "edge")},
+			successors: []string{},
+		},
+		{
+			name:       "one successor created",
+			workers:    []v2.Worker{deleted(worker("w1", "default")), worker("w3", "default"), worker("n1", "default")},
+			successors: []string{"n1"},
+		},
+		{
+			name:       "successors created",
+			workers:    []v2.Worker{worker("w3", "default"), worker("n1", "default"), worker("e1", "edge"), worker("n2", "default")},
+			successors: []string{"n1", "n2"},
+			done:       true,
+		},
+		{
+			name:       "workers added to another pool",
+			workers:    []v2.Worker{worker("w3", "default"), worker("n1", "default"), worker("e2", "edge"), worker("e3", "edge")},
+			successors: []string{"n1"},
+		},
+		{
+			name:       "an autoscaled worker added to the pool",
+			workers:    []v2.Worker{worker("n1", "default"), worker("n2", "default"), worker("n3", "default")},
+			successors: []string{"n1", "n2", "n3"},
+			done:       true,
+		},
+	}
+	for _, c := range cases {
+		successors, done := vpcClusterPoolSuccessors(c.workers, "default", replaced, existing)
+		if done != c.done || !reflect.DeepEqual(successors, c.successors) {
+			t.Errorf("%s: expected %v %t, got %v %t", c.name, c.successors, c.done, successors, done)
+		}
+	}
+}
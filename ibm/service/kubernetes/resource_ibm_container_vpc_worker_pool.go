@@ -4,13 +4,22 @@
 package kubernetes
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
@@ -22,6 +31,11 @@ import (
 
 const (
 	workerDesired = "deployed"
+
+	clusterAutoscalerAddOn       = "cluster-autoscaler"
+	clusterAutoscalerNamespace   = "kube-system"
+	clusterAutoscalerConfigMap   = "iks-ca-configmap"
+	clusterAutoscalerPoolsConfig = "workerPoolsConfig.json"
 )
 
 func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
@@ -33,6 +47,10 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Delete:   resourceIBMContainerVpcWorkerPoolDelete,
 		Exists:   resourceIBMContainerVpcWorkerPoolExists,
 		Importer: &schema.ResourceImporter{},
+		CustomizeDiff: customdiff.Sequence(
+			validate.InvokeConstraintValidator("ibm_container_vpc_worker_pool"),
+			workerPoolAutoscalingCustomizeDiff,
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
@@ -212,6 +230,8 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 				Computed:    true,
 				Description: "Autoscaling is enabled on the workerpool",
 			},
+
+			"autoscaling": workerPoolAutoscalingSchema(),
 		},
 	}
 }
@@ -221,9 +241,60 @@ func SuppressResizeForAutoscaledWorkerpool(key, oldValue, newValue string, d *sc
 	if v, ok := d.GetOk("autoscale_enabled"); ok {
 		autoscaleEnabled = v.(bool)
 	}
+	if v, ok := d.GetOk("autoscaling.0.enabled"); ok {
+		autoscaleEnabled = autoscaleEnabled || v.(bool)
+	}
 	return autoscaleEnabled
 }
 
+func workerPoolAutoscalingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Cluster autoscaler configuration of the worker pool. Installs the cluster-autoscaler add-on and manages the entry of the worker pool in its ConfigMap",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Whether the cluster autoscaler scales the worker pool",
+				},
+				"min_size": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Minimum number of worker nodes per zone",
+				},
+				"max_size": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Maximum number of worker nodes per zone",
+				},
+			},
+		},
+	}
+}
+
+// workerPoolAutoscalingCustomizeDiff rejects at plan time an autoscaling
+// min_size greater than max_size.
+func workerPoolAutoscalingCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("autoscaling.0.min_size") || !diff.NewValueKnown("autoscaling.0.max_size") {
+		return nil
+	}
+	autoscaling := diff.Get("autoscaling").([]interface{})
+	if len(autoscaling) == 0 || autoscaling[0] == nil {
+		return nil
+	}
+	config := autoscaling[0].(map[string]interface{})
+	if minSize, maxSize := config["min_size"].(int), config["max_size"].(int); minSize > maxSize {
+		return fmt.Errorf("[ERROR] autoscaling min_size %d is greater than max_size %d", minSize, maxSize)
+	}
+	return nil
+}
+
 func ResourceIBMContainerVPCWorkerPoolValidator() *validate.ResourceValidator {
 	tainteffects := "NoSchedule,PreferNoSchedule,NoExecute"
 	validateSchema := make([]validate.ValidateSchema, 0)
@@ -242,6 +313,13 @@ func ResourceIBMContainerVPCWorkerPoolValidator() *validate.ResourceValidator {
 			Required:                   true,
 			CloudDataType:              "cluster",
 			CloudDataRange:             []string{"resolved_to:id"}})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "flavor",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              validate.CloudDataKubernetesFlavor})
 
 	containerVPCWorkerPoolTaintsValidator := validate.ResourceValidator{ResourceName: "ibm_container_vpc_worker_pool", Schema: validateSchema}
 	return &containerVPCWorkerPoolTaintsValidator
@@ -345,6 +423,12 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if autoscaling, ok := d.GetOk("autoscaling"); ok {
+		if err := updateWorkerPoolAutoscaling(meta, clusterNameorID, params.Name, autoscaling.([]interface{}), d.Timeout(schema.TimeoutCreate), targetEnv); err != nil {
+			return fmt.Errorf("[ERROR] Error configuring the autoscaling of workerpool (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
 }
 
@@ -409,6 +493,16 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if d.HasChange("autoscaling") {
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return err
+		}
+		if err := updateWorkerPoolAutoscaling(meta, clusterNameOrID, workerPoolName, d.Get("autoscaling").([]interface{}), d.Timeout(schema.TimeoutUpdate), targetEnv); err != nil {
+			return fmt.Errorf("[ERROR] Error configuring the autoscaling of workerpool (%s): %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("zones") {
 		clusterID := d.Get("cluster").(string)
 		workerPoolName := d.Get("worker_pool_name").(string)
@@ -588,6 +682,9 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 		}
 	}
 	d.Set("autoscale_enabled", workerPool.AutoscaleEnabled)
+	if err := readWorkerPoolAutoscaling(d, meta, cluster, workerPool.PoolName, targetEnv); err != nil {
+		return err
+	}
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -614,6 +711,12 @@ func resourceIBMContainerVpcWorkerPoolDelete(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	if _, ok := d.GetOk("autoscaling"); ok {
+		if err := updateWorkerPoolAutoscaling(meta, clusterNameorID, d.Get("worker_pool_name").(string), nil, d.Timeout(schema.TimeoutDelete), targetEnv); err != nil {
+			log.Printf("[WARN] Error removing the autoscaling of workerpool (%s): %s", d.Id(), err)
+		}
+	}
+
 	err = workerPoolsAPI.DeleteWorkerPool(clusterNameorID, workerPoolNameorID, targetEnv)
 	if err != nil {
 		return err
@@ -741,3 +844,172 @@ func vpcworkerPoolDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPo
 		return workerFields, workerDeleteState, nil
 	}
 }
+
+// updateWorkerPoolAutoscaling installs the cluster-autoscaler add-on when
+// needed and writes the entry of the worker pool in the autoscaler ConfigMap.
+// An empty autoscaling list removes the entry.
+func updateWorkerPoolAutoscaling(meta interface{}, cluster, workerPoolName string, autoscaling []interface{}, timeout time.Duration, target v2.ClusterTargetHeader) error {
+	var entry map[string]interface{}
+	if len(autoscaling) > 0 && autoscaling[0] != nil {
+		config := autoscaling[0].(map[string]interface{})
+		entry = map[string]interface{}{
+			"name":    workerPoolName,
+			"minSize": config["min_size"].(int),
+			"maxSize": config["max_size"].(int),
+			"enabled": config["enabled"].(bool),
+		}
+		if err := enableClusterAutoscalerAddOn(meta, cluster, target); err != nil {
+			return err
+		}
+	}
+
+	clientset, err := getClusterKubeClient(meta, cluster, target)
+	if err != nil {
+		return err
+	}
+	if entry != nil {
+		if _, err := waitForClusterAutoscalerConfigMap(clientset, cluster, timeout); err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for the cluster autoscaler ConfigMap of cluster (%s): %s", cluster, err)
+		}
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := clientset.CoreV1().ConfigMaps(clusterAutoscalerNamespace).Get(context.TODO(), clusterAutoscalerConfigMap, metav1.GetOptions{})
+		if err != nil {
+			if k8serrors.IsNotFound(err) && entry == nil {
+				return nil
+			}
+			return fmt.Errorf("[ERROR] Error getting the cluster autoscaler ConfigMap of cluster (%s): %s", cluster, err)
+		}
+		pools, err := expandClusterAutoscalerPools(configMap)
+		if err != nil {
+			return err
+		}
+
+		updated := []map[string]interface{}{}
+		found := false
+		for _, pool := range pools {
+			if name, _ := pool["name"].(string); name == workerPoolName {
+				found = true
+				if entry != nil {
+					updated = append(updated, entry)
+				}
+				continue
+			}
+			updated = append(updated, pool)
+		}
+		if !found {
+			if entry == nil {
+				return nil
+			}
+			updated = append(updated, entry)
+		}
+
+		poolsConfig, err := json.Marshal(updated)
+		if err != nil {
+			return err
+		}
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		configMap.Data[clusterAutoscalerPoolsConfig] = string(poolsConfig)
+		_, err = clientset.CoreV1().ConfigMaps(clusterAutoscalerNamespace).Update(context.TODO(), configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// readWorkerPoolAutoscaling sets autoscaling from the entry of the worker pool
+// in the autoscaler ConfigMap, so that changes made by hand show up as drift.
+// The ConfigMap is only read when autoscaling is configured.
+func readWorkerPoolAutoscaling(d *schema.ResourceData, meta interface{}, cluster, workerPoolName string, target v2.ClusterTargetHeader) error {
+	if len(d.Get("autoscaling").([]interface{})) == 0 {
+		return nil
+	}
+	clientset, err := getClusterKubeClient(meta, cluster, target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading the autoscaling of worker pool %s, the config of cluster (%s) could not be downloaded: %s", workerPoolName, cluster, err)
+	}
+	autoscaling := []interface{}{}
+	configMap, err := clientset.CoreV1().ConfigMaps(clusterAutoscalerNamespace).Get(context.TODO(), clusterAutoscalerConfigMap, metav1.GetOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("[ERROR] Error reading the autoscaling of worker pool %s, the cluster autoscaler ConfigMap of cluster (%s) could not be read: %s", workerPoolName, cluster, err)
+	}
+	if err == nil {
+		pools, err := expandClusterAutoscalerPools(configMap)
+		if err != nil {
+			return err
+		}
+		for _, pool := range pools {
+			if name, _ := pool["name"].(string); name == workerPoolName {
+				minSize, _ := pool["minSize"].(float64)
+				maxSize, _ := pool["maxSize"].(float64)
+				enabled, _ := pool["enabled"].(bool)
+				autoscaling = append(autoscaling, map[string]interface{}{
+					"enabled":  enabled,
+					"min_size": int(minSize),
+					"max_size": int(maxSize),
+				})
+			}
+		}
+	}
+	return d.Set("autoscaling", autoscaling)
+}
+
+func expandClusterAutoscalerPools(configMap *corev1.ConfigMap) ([]map[string]interface{}, error) {
+	pools := []map[string]interface{}{}
+	if poolsConfig := configMap.Data[clusterAutoscalerPoolsConfig]; strings.TrimSpace(poolsConfig) != "" {
+		if err := json.Unmarshal([]byte(poolsConfig), &pools); err != nil {
+			return nil, fmt.Errorf("[ERROR] Error parsing %s of the cluster autoscaler ConfigMap: %s", clusterAutoscalerPoolsConfig, err)
+		}
+	}
+	return pools, nil
+}
+
+// enableClusterAutoscalerAddOn installs the cluster-autoscaler add-on unless
+// it is already installed.
+func enableClusterAutoscalerAddOn(meta interface{}, cluster string, target v2.ClusterTargetHeader) error {
+	csClient, err := meta.(conns.ClientSession).ContainerAPI()
+	if err != nil {
+		return err
+	}
+	Env := v1.ClusterTargetHeader{ResourceGroup: target.ResourceGroup}
+	addOns, err := csClient.AddOns().GetAddons(cluster, Env)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting the add-ons of cluster (%s): %s", cluster, err)
+	}
+	for _, addOn := range addOns {
+		if addOn.Name == clusterAutoscalerAddOn {
+			return nil
+		}
+	}
+	payload := v1.ConfigureAddOns{
+		AddonsList: []v1.AddOn{{Name: clusterAutoscalerAddOn}},
+		Enable:     true,
+	}
+	if _, err := csClient.AddOns().ConfigureAddons(cluster, &payload, Env); err != nil {
+		return fmt.Errorf("[ERROR] Error installing the %s add-on on cluster (%s): %s", clusterAutoscalerAddOn, cluster, err)
+	}
+	return nil
+}
+
+func waitForClusterAutoscalerConfigMap(clientset *kubernetes.Clientset, cluster string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the cluster autoscaler ConfigMap of cluster (%s).", cluster)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			configMap, err := clientset.CoreV1().ConfigMaps(clusterAutoscalerNamespace).Get(context.TODO(), clusterAutoscalerConfigMap, metav1.GetOptions{})
+			if err != nil {
+				if k8serrors.IsNotFound(err) {
+					return configMap, "pending", nil
+				}
+				return nil, "", err
+			}
+			return configMap, "available", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
@@ -5,6 +5,7 @@ package kubernetes_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -397,6 +398,68 @@ func testAccCheckIBMVpcContainerWorkerPoolKmsAccountEnvvar(name string) string {
 		`, name, acc.IksClusterID, acc.IksClusterVpcID, acc.IksClusterSubnetID, acc.KmsInstanceID, acc.CrkID, acc.KmsAccountID)
 }
 
+func TestAccIBMContainerVpcClusterWorkerPoolAutoscaling(t *testing.T) {
+
+	name := fmt.Sprintf("tf-vpc-worker-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMVpcContainerWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMVpcContainerWorkerPoolAutoscaling(name, true, 3, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("min_size 3 is greater than max_size 1"),
+			},
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolAutoscaling(name, true, 1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "autoscaling.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "autoscaling.0.enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "autoscaling.0.min_size", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "autoscaling.0.max_size", "3"),
+				),
+			},
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolAutoscaling(name, false, 2, 4),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "autoscaling.0.enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "autoscaling.0.min_size", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "autoscaling.0.max_size", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMVpcContainerWorkerPoolAutoscaling(name string, enabled bool, minSize, maxSize int) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+	  cluster           = "%[2]s"
+	  worker_pool_name  = "%[1]s"
+	  flavor            = "bx2.4x16"
+	  vpc_id            = "%[3]s"
+	  worker_count      = 1
+	  zones {
+		subnet_id = "%[4]s"
+		name      = "us-south-1"
+	  }
+	  autoscaling {
+		enabled  = %[5]t
+		min_size = %[6]d
+		max_size = %[7]d
+	  }
+	}
+		`, name, acc.IksClusterID, acc.IksClusterVpcID, acc.IksClusterSubnetID, enabled, minSize, maxSize)
+}
+
 func TestAccIBMContainerVpcOpenshiftClusterWorkerPoolBasic(t *testing.T) {
 
 	name := fmt.Sprintf("tf-vpc-cluster-%d", acctest.RandIntRange(10, 100))
@@ -5,6 +5,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -21,13 +23,15 @@ import (
 func ResourceIBMContainerWorkerPool() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMContainerWorkerPoolCreate,
-		Read:     resourceIBMContainerWorkerPoolRead,
-		Update:   resourceIBMContainerWorkerPoolUpdate,
-		Delete:   resourceIBMContainerWorkerPoolDelete,
-		Exists:   resourceIBMContainerWorkerPoolExists,
-		Importer: &schema.ResourceImporter{},
+		Create:        resourceIBMContainerWorkerPoolCreate,
+		Read:          resourceIBMContainerWorkerPoolRead,
+		Update:        resourceIBMContainerWorkerPoolUpdate,
+		Delete:        resourceIBMContainerWorkerPoolDelete,
+		Exists:        resourceIBMContainerWorkerPoolExists,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: workerPoolAutoscalingCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
 			Update: schema.DefaultTimeout(90 * time.Minute),
 		},
 
@@ -197,6 +201,8 @@ func ResourceIBMContainerWorkerPool() *schema.Resource {
 				Computed:    true,
 				Description: "Autoscaling is enabled on the workerpool",
 			},
+
+			"autoscaling": workerPoolAutoscalingSchema(),
 		},
 	}
 }
@@ -289,6 +295,13 @@ func resourceIBMContainerWorkerPoolCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	if autoscaling, ok := d.GetOk("autoscaling"); ok {
+		v2Env := v2.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
+		if err := updateWorkerPoolAutoscaling(meta, clusterNameorID, workerPoolConfig.Name, autoscaling.([]interface{}), d.Timeout(schema.TimeoutCreate), v2Env); err != nil {
+			return fmt.Errorf("[ERROR] Error configuring the autoscaling of workerpool (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceIBMContainerWorkerPoolRead(d, meta)
 }
 
@@ -341,6 +354,10 @@ func resourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface{}
 		d.Set("disk_encryption", false)
 	}
 	d.Set("autoscale_enabled", workerPool.AutoscaleEnabled)
+	v2Env := v2.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
+	if err := readWorkerPoolAutoscaling(d, meta, cluster, workerPool.Name, v2Env); err != nil {
+		return err
+	}
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -406,6 +423,13 @@ func resourceIBMContainerWorkerPoolUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	if d.HasChange("autoscaling") {
+		v2Env := v2.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
+		if err := updateWorkerPoolAutoscaling(meta, clusterNameorID, d.Get("worker_pool_name").(string), d.Get("autoscaling").([]interface{}), d.Timeout(schema.TimeoutUpdate), v2Env); err != nil {
+			return fmt.Errorf("[ERROR] Error configuring the autoscaling of workerpool (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceIBMContainerWorkerPoolRead(d, meta)
 }
 
@@ -427,6 +451,13 @@ func resourceIBMContainerWorkerPoolDelete(d *schema.ResourceData, meta interface
 		return err
 	}
 
+	if _, ok := d.GetOk("autoscaling"); ok {
+		v2Env := v2.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
+		if err := updateWorkerPoolAutoscaling(meta, clusterNameorID, d.Get("worker_pool_name").(string), nil, d.Timeout(schema.TimeoutUpdate), v2Env); err != nil {
+			log.Printf("[WARN] Error removing the autoscaling of workerpool (%s): %s", d.Id(), err)
+		}
+	}
+
 	err = workerPoolsAPI.DeleteWorkerPool(clusterNameorID, workerPoolNameorID, targetEnv)
 	if err != nil {
 		return err
@@ -36,12 +36,12 @@ const (
 
 func ResourceIBMResourceInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMResourceInstanceCreate,
-		Read:     ResourceIBMResourceInstanceRead,
-		Update:   ResourceIBMResourceInstanceUpdate,
-		Delete:   ResourceIBMResourceInstanceDelete,
-		Exists:   ResourceIBMResourceInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: flex.APIErrorContextFunc(ResourceIBMResourceInstanceCreate),
+		ReadContext:   flex.APIErrorContextFunc(ResourceIBMResourceInstanceRead),
+		UpdateContext: flex.APIErrorContextFunc(ResourceIBMResourceInstanceUpdate),
+		DeleteContext: flex.APIErrorContextFunc(ResourceIBMResourceInstanceDelete),
+		Exists:        ResourceIBMResourceInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -51,8 +51,9 @@ func ResourceIBMResourceInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
+			validate.InvokeConstraintValidator("ibm_resource_instance"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -114,6 +115,7 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				Description: "Arbitrary parameters to pass in Json string format",
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -389,12 +391,20 @@ func ResourceIBMResourceInstanceValidator() *validate.ResourceValidator {
 			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
 			MinValueLength:             1,
 			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "plan",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              validate.CloudDataServicePlan,
+			CloudDataRange:             []string{"scope:service"},
+			Required:                   true})
 
 	ibmResourceInstanceResourceValidator := validate.ResourceValidator{ResourceName: "ibm_resource_instance", Schema: validateSchema}
 	return &ibmResourceInstanceResourceValidator
 }
 
-func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return err
@@ -417,7 +427,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+		return flex.NewAPIError(err, nil, "resource-catalog", "FindByName", "ibm_resource_instance", "")
 	}
 
 	if metadata, ok := serviceOff[0].Metadata.(*models.ServiceResourceMetadata); ok {
@@ -430,13 +440,13 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+		return flex.NewAPIError(err, nil, "resource-catalog", "GetServicePlanID", "ibm_resource_instance", "")
 	}
 	rsInst.ResourcePlanID = &servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err)
+		return flex.NewAPIError(err, nil, "resource-catalog", "ListDeployments", "ibm_resource_instance", "")
 	}
 	if len(deployments) == 0 {
 		return fmt.Errorf("[ERROR] No deployment found for service plan : %s", plan)
@@ -504,23 +514,23 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 	rsInst.Parameters = params
 
 	//Start to create resource instance
-	instance, resp, err := rsConClient.CreateResourceInstance(&rsInst)
+	instance, resp, err := rsConClient.CreateResourceInstanceWithContext(ctx, &rsInst)
 	if err != nil {
 		log.Printf(
 			"Error when creating resource instance: %s, Instance info  NAME->%s, LOCATION->%s, GROUP_ID->%s, PLAN_ID->%s",
 			err, *rsInst.Name, *rsInst.Target, *rsInst.ResourceGroup, *rsInst.ResourcePlanID)
-		return fmt.Errorf("[ERROR] Error when creating resource instance: %s with resp code: %s", err, resp)
+		return flex.NewAPIError(err, resp, "resource-controller", "CreateResourceInstance", "ibm_resource_instance", "")
 	}
 
 	d.SetId(*instance.ID)
 
-	_, err = waitForResourceInstanceCreate(d, meta)
+	_, err = waitForResourceInstanceCreate(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %w", d.Id(), err)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -529,9 +539,9 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return ResourceIBMResourceInstanceRead(d, meta)
+	return ResourceIBMResourceInstanceRead(ctx, d, meta)
 }
-func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMResourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return err
@@ -542,9 +552,9 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 		ID: &instanceID,
 	}
 
-	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instance, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return flex.NewAPIError(err, resp, "resource-controller", "GetResourceInstance", "ibm_resource_instance", instanceID)
 	}
 
 	tags, err := flex.GetTagsUsingCRN(meta, *instance.CRN)
@@ -573,7 +583,7 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	serviceOff, err := rsCatRepo.GetServiceName(*instance.ResourceID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+		return flex.NewAPIError(err, nil, "resource-catalog", "GetServiceName", "ibm_resource_instance", instanceID)
 	}
 
 	d.Set("service", serviceOff)
@@ -591,7 +601,7 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(*instance.ResourcePlanID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+		return flex.NewAPIError(err, nil, "resource-catalog", "GetServicePlanName", "ibm_resource_instance", instanceID)
 	}
 	d.Set("plan", servicePlan)
 	d.Set("guid", instance.GUID)
@@ -652,7 +662,7 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return err
@@ -679,12 +689,12 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 		serviceOff, err := rsCatRepo.FindByName(service, true)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+			return flex.NewAPIError(err, nil, "resource-catalog", "FindByName", "ibm_resource_instance", instanceID)
 		}
 
 		servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+			return flex.NewAPIError(err, nil, "resource-catalog", "GetServicePlanID", "ibm_resource_instance", instanceID)
 		}
 
 		resourceInstanceUpdate.ResourcePlanID = &servicePlan
@@ -701,9 +711,9 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		ID: &instanceID,
 	}
 	if d.HasChange("parameters") {
-		instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+		instance, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+			return flex.NewAPIError(err, resp, "resource-controller", "GetResourceInstance", "ibm_resource_instance", instanceID)
 		}
 
 		if parameters, ok := d.GetOk("parameters"); ok {
@@ -752,12 +762,12 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 			resourceInstanceUpdate.Parameters = params
 		}
 	}
-	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	instance, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting resource instance: %s with resp code: %s", err, resp)
+		return flex.NewAPIError(err, resp, "resource-controller", "GetResourceInstance", "ibm_resource_instance", instanceID)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -766,20 +776,20 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
+	_, resp, err = rsConClient.UpdateResourceInstanceWithContext(ctx, &resourceInstanceUpdate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp)
+		return flex.NewAPIError(err, resp, "resource-controller", "UpdateResourceInstance", "ibm_resource_instance", instanceID)
 	}
 
-	_, err = waitForResourceInstanceUpdate(d, meta)
+	_, err = waitForResourceInstanceUpdate(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %w", d.Id(), err)
 	}
 
-	return ResourceIBMResourceInstanceRead(d, meta)
+	return ResourceIBMResourceInstanceRead(ctx, d, meta)
 }
 
-func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMResourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return err
@@ -791,17 +801,17 @@ func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{})
 		Recursive: &recursive,
 	}
 
-	resp, error := rsConClient.DeleteResourceInstance(&resourceInstanceDelete)
+	resp, error := rsConClient.DeleteResourceInstanceWithContext(ctx, &resourceInstanceDelete)
 	if error != nil {
 		if resp != nil && resp.StatusCode == 410 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting resource instance: %s with resp code: %s", error, resp)
+		return flex.NewAPIError(error, resp, "resource-controller", "DeleteResourceInstance", "ibm_resource_instance", id)
 	}
 
-	_, err = waitForResourceInstanceDelete(d, meta)
+	_, err = waitForResourceInstanceDelete(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
+		return fmt.Errorf("[ERROR] Error waiting for resource instance (%s) to be deleted: %w", d.Id(), err)
 	}
 
 	d.SetId("")
@@ -823,7 +833,7 @@ func ResourceIBMResourceInstanceExists(d *schema.ResourceData, meta interface{})
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting resource instance: %s with resp code: %s", err, resp)
+		return false, flex.NewAPIError(err, resp, "resource-controller", "GetResourceInstance", "ibm_resource_instance", instanceID)
 	}
 	if instance != nil && (strings.Contains(*instance.State, "removed") || strings.Contains(*instance.State, RsInstanceReclamation)) {
 		log.Printf("[WARN] Removing instance from state because it's in removed or pending_reclamation state")
@@ -834,7 +844,7 @@ func ResourceIBMResourceInstanceExists(d *schema.ResourceData, meta interface{})
 	return *instance.ID == instanceID, nil
 }
 
-func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -848,12 +858,12 @@ func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (in
 		Pending: []string{RsInstanceProgressStatus, RsInstanceInactiveStatus, RsInstanceProvisioningStatus},
 		Target:  []string{RsInstanceSuccessStatus},
 		Refresh: func() (interface{}, string, error) {
-			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+			instance, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 			if err != nil {
 				if resp != nil && resp.StatusCode == 404 {
 					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v", d.Id(), err)
 				}
-				return nil, "", fmt.Errorf("[ERROR] Get the resource instance %s failed with resp code: %s, err: %v", d.Id(), resp, err)
+				return nil, "", flex.NewAPIError(err, resp, "resource-controller", "GetResourceInstance", "ibm_resource_instance", d.Id())
 			}
 			if *instance.State == RsInstanceFailStatus {
 				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %v", d.Id(), err)
@@ -865,10 +875,10 @@ func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -882,12 +892,12 @@ func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 		Pending: []string{RsInstanceProgressStatus, RsInstanceInactiveStatus},
 		Target:  []string{RsInstanceSuccessStatus},
 		Refresh: func() (interface{}, string, error) {
-			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+			instance, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 			if err != nil {
 				if resp != nil && resp.StatusCode == 404 {
 					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v", d.Id(), err)
 				}
-				return nil, "", fmt.Errorf("[ERROR] Get the resource instance %s failed with resp code: %s, err: %v", d.Id(), resp, err)
+				return nil, "", flex.NewAPIError(err, resp, "resource-controller", "GetResourceInstance", "ibm_resource_instance", d.Id())
 			}
 			if *instance.State == RsInstanceFailStatus {
 				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %v", d.Id(), err)
@@ -899,10 +909,10 @@ func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForResourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -915,12 +925,12 @@ func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 		Pending: []string{RsInstanceProgressStatus, RsInstanceInactiveStatus, RsInstanceSuccessStatus},
 		Target:  []string{RsInstanceRemovedStatus, RsInstanceReclamation},
 		Refresh: func() (interface{}, string, error) {
-			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+			instance, resp, err := rsConClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 			if err != nil {
 				if resp != nil && resp.StatusCode == 404 {
 					return instance, RsInstanceSuccessStatus, nil
 				}
-				return nil, "", fmt.Errorf("[ERROR] Get the resource instance %s failed with resp code: %s, err: %v", d.Id(), resp, err)
+				return nil, "", flex.NewAPIError(err, resp, "resource-controller", "GetResourceInstance", "ibm_resource_instance", d.Id())
 			}
 			if *instance.State == RsInstanceFailStatus {
 				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed to delete: %v", d.Id(), err)
@@ -932,7 +942,7 @@ func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func FilterDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
@@ -4,6 +4,7 @@
 package resourcecontroller
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -22,12 +23,12 @@ import (
 
 func ResourceIBMResourceKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMResourceKeyCreate,
-		Read:     resourceIBMResourceKeyRead,
-		Update:   resourceIBMResourceKeyUpdate,
-		Delete:   resourceIBMResourceKeyDelete,
-		Exists:   resourceIBMResourceKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: flex.APIErrorContextFunc(resourceIBMResourceKeyCreate),
+		ReadContext:   flex.APIErrorContextFunc(resourceIBMResourceKeyRead),
+		UpdateContext: flex.APIErrorContextFunc(resourceIBMResourceKeyUpdate),
+		DeleteContext: flex.APIErrorContextFunc(resourceIBMResourceKeyDelete),
+		Exists:        resourceIBMResourceKeyExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -208,7 +209,7 @@ func ResourceIBMResourceKeyValidator() *validate.ResourceValidator {
 	return &ibmResourceKeyResourceValidator
 }
 
-func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return err
@@ -242,9 +243,9 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	resourceInstance, sourceCRN, err := getResourceInstanceAndCRN(d, meta)
+	resourceInstance, sourceCRN, err := getResourceInstanceAndCRN(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource key when get instance and CRN: %s", err)
+		return fmt.Errorf("[ERROR] Error creating resource key when get instance and CRN: %w", err)
 	}
 
 	serviceID := resourceInstance.ResourceID
@@ -256,7 +257,7 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 
 	service, err := rsCatClient.ResourceCatalog().Get(*serviceID, true)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource key when get service: %s", err)
+		return flex.NewAPIError(err, nil, "resource-catalog", "GetService", "ibm_resource_key", "")
 	}
 
 	resourceKeyCreate := rc.CreateResourceKeyOptions{
@@ -266,7 +267,7 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 	if r, ok := d.GetOk("role"); ok {
 		role := r.(string)
-		serviceRole, err := getRoleFromName(role, service.Name, meta)
+		serviceRole, err := getRoleFromName(ctx, role, service.Name, meta)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error creating resource key when get role: %s", err)
 		}
@@ -274,21 +275,21 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 		resourceKeyCreate.Role = serviceRole.RoleID
 	}
 
-	resourceKey, resp, err := rsContClient.CreateResourceKey(&resourceKeyCreate)
+	resourceKey, resp, err := rsContClient.CreateResourceKeyWithContext(ctx, &resourceKeyCreate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource key: %s with resp code: %s", err, resp)
+		return flex.NewAPIError(err, resp, "resource-controller", "CreateResourceKey", "ibm_resource_key", "")
 	}
 
 	d.SetId(*resourceKey.ID)
 
-	return resourceIBMResourceKeyRead(d, meta)
+	return resourceIBMResourceKeyRead(ctx, d, meta)
 }
 
-func resourceIBMResourceKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return err
@@ -298,9 +299,9 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 		ID: &resourceKeyID,
 	}
 
-	resourceKey, resp, err := rsContClient.GetResourceKey(&resourceKeyGet)
+	resourceKey, resp, err := rsContClient.GetResourceKeyWithContext(ctx, &resourceKeyGet)
 	if err != nil || resourceKey == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource key: %s with resp : %s", err, resp)
+		return flex.NewAPIError(err, resp, "resource-controller", "GetResourceKey", "ibm_resource_key", resourceKeyID)
 	}
 	var credInterface map[string]interface{}
 	cred, _ := json.Marshal(resourceKey.Credentials)
@@ -334,7 +335,7 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 				AccountID:   resourceKey.AccountID,
 				ServiceName: &resourceCRN,
 			}
-			roleList, resp, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+			roleList, resp, err := iamPolicyManagementClient.ListRolesWithContext(ctx, listRoleOptions)
 			roles := flex.MapRoleListToPolicyRoles(*roleList)
 			if err == nil && len(roles) > 0 {
 				for _, role := range roles {
@@ -387,7 +388,7 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return err
@@ -398,9 +399,9 @@ func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) erro
 		ID: &resourceKeyID,
 	}
 
-	resp, err := rsContClient.DeleteResourceKey(&resourceKeyDelete)
+	resp, err := rsContClient.DeleteResourceKeyWithContext(ctx, &resourceKeyDelete)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting resource key: %s with resp code: %s", err, resp)
+		return flex.NewAPIError(err, resp, "resource-controller", "DeleteResourceKey", "ibm_resource_key", resourceKeyID)
 	}
 
 	d.SetId("")
@@ -423,7 +424,7 @@ func resourceIBMResourceKeyExists(d *schema.ResourceData, meta interface{}) (boo
 		if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting resource key: %s with resp code: %s", err, resp)
+		return false, flex.NewAPIError(err, resp, "resource-controller", "GetResourceKey", "ibm_resource_key", resourceKeyID)
 	}
 	if err == nil && *resourceKey.State == "removed" {
 		return false, nil
@@ -432,7 +433,7 @@ func resourceIBMResourceKeyExists(d *schema.ResourceData, meta interface{}) (boo
 	return *resourceKey.ID == resourceKeyID, nil
 }
 
-func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.ResourceInstance, *string, error) {
+func getResourceInstanceAndCRN(ctx context.Context, d *schema.ResourceData, meta interface{}) (*rc.ResourceInstance, *string, error) {
 	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return nil, nil, err
@@ -442,7 +443,7 @@ func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.Re
 		resourceInstanceGet := rc.GetResourceInstanceOptions{
 			ID: &insIdString,
 		}
-		instance, resp, err := rsContClient.GetResourceInstance(&resourceInstanceGet)
+		instance, resp, err := rsContClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 		if err != nil {
 			log.Printf("Error when get resource instance in getResourceInstanceAndCRN: %s with resp code: %s", err, resp)
 			return nil, nil, err
@@ -454,7 +455,7 @@ func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.Re
 	resourceAliasGet := rc.GetResourceAliasOptions{
 		ID: &aliasID,
 	}
-	alias, resp, err := rsContClient.GetResourceAlias(&resourceAliasGet)
+	alias, resp, err := rsContClient.GetResourceAliasWithContext(ctx, &resourceAliasGet)
 	if err != nil {
 		log.Printf("Error when get resource alias in getResourceInstanceAndCRN: %s with resp code: %s", err, resp)
 		return nil, nil, err
@@ -462,7 +463,7 @@ func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.Re
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: alias.ResourceInstanceID,
 	}
-	instance, resp, err := rsContClient.GetResourceInstance(&resourceInstanceGet)
+	instance, resp, err := rsContClient.GetResourceInstanceWithContext(ctx, &resourceInstanceGet)
 	if err != nil {
 		log.Printf("Error when get resource instance in getResourceInstanceAndCRN: %s with resp code: %s", err, resp)
 		return nil, nil, err
@@ -471,7 +472,7 @@ func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.Re
 
 }
 
-func getRoleFromName(roleName, serviceName string, meta interface{}) (iampolicymanagementv1.PolicyRole, error) {
+func getRoleFromName(ctx context.Context, roleName, serviceName string, meta interface{}) (iampolicymanagementv1.PolicyRole, error) {
 
 	role := iampolicymanagementv1.PolicyRole{}
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
@@ -489,7 +490,7 @@ func getRoleFromName(roleName, serviceName string, meta interface{}) (iampolicym
 		ServiceName: &serviceName,
 	}
 
-	roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	roleList, _, err := iamPolicyManagementClient.ListRolesWithContext(ctx, listRoleOptions)
 	if err != nil {
 		return role, err
 	}
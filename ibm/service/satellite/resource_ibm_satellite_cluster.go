@@ -70,7 +70,7 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				return flex.ImmutableResourceCustomizeDiff([]string{"name", "location", "resource_group_id", "crn_token"}, diff)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -252,6 +252,7 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				Default:     false,
 				Description: "Boolean value true if Public service endpoint to be disabled",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			"tags": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -466,7 +467,7 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || flex.HasDefaultTags(meta) {
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 			Cluster: flex.PtrToString(clusterId),
 		}
@@ -695,7 +696,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || d.HasChange(flex.ResourceTagsAll) || v != "" {
 		oldList, newList := d.GetChange("tags")
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 			Cluster:            &clusterID,
@@ -1,6 +1,7 @@
 package secretsmanager
 
 import (
+	"context"
 	"fmt"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 	"github.com/go-openapi/strfmt"
@@ -62,6 +63,76 @@ func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManag
 	return newClient
 }
 
+// Clone the base secrets manager client and set the API endpoint per the instance
+// of the given secret CRN, return the client with the ID of the secret
+func GetClientWithSecretCRN(originalClient *secretsmanagerv2.SecretsManagerV2, secretCRN string) (*secretsmanagerv2.SecretsManagerV2, string, error) {
+	// crn:v1:<cname>:<ctype>:secrets-manager:<region>:a/<account>:<instance id>:secret:<secret id>
+	parts := strings.Split(secretCRN, ":")
+	if len(parts) != 10 || parts[4] != "secrets-manager" || parts[8] != "secret" {
+		return nil, "", fmt.Errorf("%s is not the CRN of a Secrets Manager secret", secretCRN)
+	}
+	endpointType := "public"
+	if strings.Contains(originalClient.Service.GetServiceURL(), "private.") {
+		endpointType = "private"
+	}
+	return getClientWithInstanceEndpoint(originalClient, parts[7], parts[5], endpointType), parts[9], nil
+}
+
+// Get the ID of the current version of a certificate secret, it changes when
+// the certificate is rotated
+func GetCertificateCurrentVersionID(ctx context.Context, client *secretsmanagerv2.SecretsManagerV2, secretID string) (string, error) {
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+	getSecretVersionMetadataOptions.SetSecretID(secretID)
+	getSecretVersionMetadataOptions.SetID("current")
+
+	versionMetadataIntf, response, err := client.GetSecretVersionMetadataWithContext(ctx, getSecretVersionMetadataOptions)
+	if err != nil {
+		return "", fmt.Errorf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+	}
+	switch versionMetadata := versionMetadataIntf.(type) {
+	case *secretsmanagerv2.ImportedCertificateVersionMetadata:
+		return *versionMetadata.ID, nil
+	case *secretsmanagerv2.PrivateCertificateVersionMetadata:
+		return *versionMetadata.ID, nil
+	case *secretsmanagerv2.PublicCertificateVersionMetadata:
+		return *versionMetadata.ID, nil
+	}
+	return "", fmt.Errorf("the secret %s is not a certificate", secretID)
+}
+
+// Get the certificate, the intermediate or issuing CA certificates and the
+// private key of the current version of a certificate secret, in PEM format
+func GetCertificate(ctx context.Context, client *secretsmanagerv2.SecretsManagerV2, secretID string) (certificate, intermediate, privateKey string, err error) {
+	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+	getSecretOptions.SetID(secretID)
+
+	secretIntf, response, err := client.GetSecretWithContext(ctx, getSecretOptions)
+	if err != nil {
+		return "", "", "", fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response)
+	}
+	var certificatePtr, intermediatePtr, privateKeyPtr *string
+	switch secret := secretIntf.(type) {
+	case *secretsmanagerv2.ImportedCertificate:
+		certificatePtr, intermediatePtr, privateKeyPtr = secret.Certificate, secret.Intermediate, secret.PrivateKey
+	case *secretsmanagerv2.PrivateCertificate:
+		certificatePtr, intermediatePtr, privateKeyPtr = secret.Certificate, secret.IssuingCa, secret.PrivateKey
+	case *secretsmanagerv2.PublicCertificate:
+		certificatePtr, intermediatePtr, privateKeyPtr = secret.Certificate, secret.Intermediate, secret.PrivateKey
+	default:
+		return "", "", "", fmt.Errorf("the secret %s is not a certificate", secretID)
+	}
+	if certificatePtr != nil {
+		certificate = *certificatePtr
+	}
+	if intermediatePtr != nil {
+		intermediate = *intermediatePtr
+	}
+	if privateKeyPtr != nil {
+		privateKey = *privateKeyPtr
+	}
+	return certificate, intermediate, privateKey, nil
+}
+
 // Add the fields needed for building the instance endpoint to the given schema
 func AddInstanceFields(resource *schema.Resource) *schema.Resource {
 	resource.Schema["instance_id"] = &schema.Schema{
@@ -55,7 +55,7 @@ func ResourceIBMTransitGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -83,6 +83,7 @@ func ResourceIBMTransitGateway() *schema.Resource {
 				Description: "Allow global routing for a Transit Gateway. If unspecified, the default value is false",
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			tgGatewayTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -221,7 +222,7 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(tgGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(tgGatewayTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(tgGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *tgw.Crn)
 		if err != nil {
@@ -353,7 +354,7 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 			updateTransitGatewayOptions.Global = &global
 		}
 	}
-	if d.HasChange(tgGatewayTags) {
+	if d.HasChange(tgGatewayTags) || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange(tgGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *tgw.Crn)
 		if err != nil {
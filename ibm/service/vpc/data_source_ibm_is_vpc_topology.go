@@ -0,0 +1,596 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isVPCTopologyVPC        = "vpc"
+	isVPCTopologyIncludeDot = "include_dot"
+	isVPCTopologyGraph      = "graph"
+	isVPCTopologyDot        = "dot"
+
+	// The node standing for all the addresses outside of the VPC
+	isVPCTopologyInternet = "internet"
+	// The address of the internet node, matched by the rules allowing any address
+	isVPCTopologyInternetAddress = "0.0.0.0/0"
+)
+
+func DataSourceIBMISVPCTopology() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISVPCTopologyRead,
+
+		Schema: map[string]*schema.Schema{
+			isVPCTopologyVPC: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPC identifier",
+			},
+			isVPCTopologyIncludeDot: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to also export the graph in the Graphviz DOT format",
+			},
+			isVPCTopologyGraph: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The nodes and edges of the VPC, and the ports each node can reach on the others, as JSON",
+			},
+			isVPCTopologyDot: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The graph in the Graphviz DOT format, when include_dot is set",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISVPCTopologyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vpcID := d.Get(isVPCTopologyVPC).(string)
+	topology, err := collectIBMISVPCTopology(context, sess, vpcID)
+	if err != nil {
+		return flex.DiagFromErr(err)
+	}
+
+	graph := topology.graph()
+	graphJSON, err := json.Marshal(graph)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error encoding the topology of VPC %s: %s", vpcID, err))
+	}
+	d.SetId(vpcID)
+	d.Set(isVPCTopologyGraph, string(graphJSON))
+	if d.Get(isVPCTopologyIncludeDot).(bool) {
+		d.Set(isVPCTopologyDot, graph.dot())
+	} else {
+		d.Set(isVPCTopologyDot, "")
+	}
+	return nil
+}
+
+// vpcTopology is everything of a VPC that decides which of its endpoints
+// can reach the others.
+type vpcTopology struct {
+	vpcID                    string
+	subnets                  []vpcv1.Subnet
+	routingTables            []vpcv1.RoutingTable
+	routes                   map[string][]vpcv1.Route
+	networkACLs              []vpcv1.NetworkACL
+	securityGroups           []vpcv1.SecurityGroup
+	instances                []vpcv1.Instance
+	virtualNetworkInterfaces []vpcv1.VirtualNetworkInterface
+	publicGateways           []vpcv1.PublicGateway
+	endpointGateways         []vpcv1.EndpointGateway
+	loadBalancers            []vpcv1.LoadBalancer
+	// The floating IP addresses by the ID of their target
+	floatingIPs map[string]string
+}
+
+// collectIBMISVPCTopology lists the resources of the VPC. The resources that
+// cannot be listed for a VPC are listed for the account, then filtered.
+func collectIBMISVPCTopology(context context.Context, sess *vpcv1.VpcV1, vpcID string) (*vpcTopology, error) {
+	const resourceType = "ibm_is_vpc_topology"
+	t := &vpcTopology{
+		vpcID:       vpcID,
+		routes:      map[string][]vpcv1.Route{},
+		floatingIPs: map[string]string{},
+	}
+	var response *core.DetailedResponse
+	var err error
+
+	t.subnets, response, err = listIBMISPages(func(start *string) ([]vpcv1.Subnet, interface{}, *core.DetailedResponse, error) {
+		collection, response, err := sess.ListSubnetsWithContext(context, &vpcv1.ListSubnetsOptions{VPCID: &vpcID, Start: start})
+		if err != nil {
+			return nil, nil, response, err
+		}
+		return collection.Subnets, collection.Next, response, nil
+	})
+	if err != nil {
+		return nil, flex.NewAPIError(err, response, "vpc", "ListSubnets", resourceType, vpcID)
+	}
+	subnetIDs := map[string]bool{}
+	for _, subnet := range t.subnets {
+		subnetIDs[*subnet.ID] = true
+	}
+
+	t.routingTables, response, err = listIBMISPages(func(start *string) ([]vpcv1.RoutingTable, interface{}, *core.DetailedResponse, error) {
+		collection, response, err := sess.ListVPCRoutingTablesWithContext(context, &vpcv1.ListVPCRoutingTablesOptions{VPCID: &vpcID, Start: start})
+		if err != nil {
+			return nil, nil, response, err
+		}
+		return collection.RoutingTables, collection.Next, response, nil
+	})
+	if err != nil {
+		return nil, flex.NewAPIError(err, response, "vpc", "ListVPCRoutingTables", resourceType, vpcID)
+	}
+	for _, routingTable := range t.routingTables {
+		routes, response, err := listIBMISVPCRoutingTableRoutes(context, sess, vpcID, *routingTable.ID)
+		if err != nil {
+			return nil, flex.NewAPIError(err, response, "vpc", "ListVPCRoutingTableRoutes", resourceType, *routingTable.ID)
+		}
+		t.routes[*routingTable.ID] = routes
+	}
+
+	networkACLs, response, err := listIBMISPages(func(start *string) ([]vpcv1.NetworkACL, interface{}, *core.DetailedResponse, error) {
+		collection, response, err := sess.ListNetworkAclsWithContext(context, &vpcv1.ListNetworkAclsOptions{Start: start})
+		if err != nil {
+			return nil, nil, response, err
+		}
+		return collection.NetworkAcls, collection.Next, response, nil
+	})
+	if err != nil {
+		return nil, flex.NewAPIError(err, response, "vpc", "ListNetworkAcls", resourceType, vpcID)
+	}
+	for _, networkACL := range networkACLs {
+		if networkACL.VPC != nil && *networkACL.VPC.ID == vpcID {
+			t.networkACLs = append(t.networkACLs, networkACL)
+		}
+	}
+
+	t.securityGroups, response, err = listIBMISPages(func(start *string) ([]vpcv1.SecurityGroup, interface{}, *core.DetailedResponse, error) {
+		collection, response, err := sess.ListSecurityGroupsWithContext(context, &vpcv1.ListSecurityGroupsOptions{VPCID: &vpcID, Start: start})
+		if err != nil {
+			return nil, nil, response, err
+		}
+		return collection.SecurityGroups, collection.Next, response, nil
+	})
+	if err != nil {
+		return nil, flex.NewAPIError(err, response, "vpc", "ListSecurityGroups", resourceType, vpcID)
+	}
+
+	t.instances, response, err = listIBMISPages(func(start *string) ([]vpcv1.Instance, interface{}, *core.DetailedResponse, error) {
+		collection, response, err := sess.ListInstancesWithContext(context, &vpcv1.ListInstancesOptions{VPCID: &vpcID, Start: start})
+		if err != nil {
+			return nil, nil, response, err
+		}
+		return collection.Instances, collection.Next, response, nil
+	})
+	if err != nil {
+		return nil, flex.NewAPIError(err, response, "vpc", "ListInstances", resourceType, vpcID)
+	}
+
+	virtualNetworkInterfaces, response, err := listIBMISPages(func(start *string) ([]vpcv1.VirtualNetworkInterface, interface{}, *core.DetailedResponse, error) {
+		collection, response, err := sess.ListVirtualNetworkInterfacesWithContext(context, &vpcv1.ListVirtualNetworkInterfacesOptions{Start: start})
+		if err != nil {
+			return nil, nil, response, err
+		}
+		return collection.VirtualNetworkInterfaces, collection.Next, response, nil
+	})
+	if err != nil {
+		return nil, flex.NewAPIError(err, response, "vpc", "ListVirtualNetworkInterfaces", resourceType, vpcID)
+	}
+	for _, vni := range virtualNetworkInterfaces {
+		if vni.VPC != nil && *vni.VPC.ID == vpcID {
+			t.virtualNetworkInterfaces = append(t.virtualNetworkInterfaces, vni)
+		}
+	}
+
+	publicGateways, response, err := listIBMISPages(func(start *string) ([]vpcv1.PublicGateway, interface{}, *core.DetailedResponse, error) {
+		collection, response, err := sess.ListPublicGatewaysWithContext(context, &vpcv1.ListPublicGatewaysOptions{Start: start})
+		if err != nil {
+			return nil, nil, response, err
+		}
+		return collection.PublicGateways, collection.Next, response, nil
+	})
+	if err != nil {
+		return nil, flex.NewAPIError(err, response, "vpc", "ListPublicGateways", resourceType, vpcID)
+	}
+	for _, publicGateway := range publicGateways {
+		if publicGateway.VPC != nil && *publicGateway.VPC.ID == vpcID {
+			t.publicGateways = append(t.publicGateways, publicGateway)
+		}
+	}
+
+	t.endpointGateways, response, err = listIBMISPages(func(start *string) ([]vpcv1.EndpointGateway, interface{}, *core.DetailedResponse, error) {
+		collection, response, err := sess.ListEndpointGatewaysWithContext(context, &vpcv1.ListEndpointGatewaysOptions{VPCID: &vpcID, Start: start})
+		if err != nil {
+			return nil, nil, response, err
+		}
+		return collection.EndpointGateways, collection.Next, response, nil
+	})
+	if err != nil {
+		return nil, flex.NewAPIError(err, response, "vpc", "ListEndpointGateways", resourceType, vpcID)
+	}
+
+	loadBalancers, response, err := listIBMISPages(func(start *string) ([]vpcv1.LoadBalancer, interface{}, *core.DetailedResponse, error) {
+		collection, response, err := sess.ListLoadBalancersWithContext(context, &vpcv1.ListLoadBalancersOptions{Start: start})
+		if err != nil {
+			return nil, nil, response, err
+		}
+		return collection.LoadBalancers, collection.Next, response, nil
+	})
+	if err != nil {
+		return nil, flex.NewAPIError(err, response, "vpc", "ListLoadBalancers", resourceType, vpcID)
+	}
+	for _, lb := range loadBalancers {
+		for _, subnet := range lb.Subnets {
+			if subnetIDs[*subnet.ID] {
+				t.loadBalancers = append(t.loadBalancers, lb)
+				break
+			}
+		}
+	}
+
+	floatingIPs, response, err := listIBMISPages(func(start *string) ([]vpcv1.FloatingIP, interface{}, *core.DetailedResponse, error) {
+		collection, response, err := sess.ListFloatingIpsWithContext(context, &vpcv1.ListFloatingIpsOptions{Start: start})
+		if err != nil {
+			return nil, nil, response, err
+		}
+		return collection.FloatingIps, collection.Next, response, nil
+	})
+	if err != nil {
+		return nil, flex.NewAPIError(err, response, "vpc", "ListFloatingIps", resourceType, vpcID)
+	}
+	for _, floatingIP := range floatingIPs {
+		if target, ok := floatingIP.Target.(*vpcv1.FloatingIPTarget); ok && target != nil && target.ID != nil {
+			t.floatingIPs[*target.ID] = *floatingIP.Address
+		}
+	}
+
+	log.Printf("[DEBUG] VPC %s has %d subnets, %d instances, %d virtual network interfaces, %d endpoint gateways and %d load balancers",
+		vpcID, len(t.subnets), len(t.instances), len(t.virtualNetworkInterfaces), len(t.endpointGateways), len(t.loadBalancers))
+	return t, nil
+}
+
+// listIBMISPages returns the items of all the pages of a VPC list call. The
+// list function fetches the page at the start token, nil for the first page,
+// and returns its items with the link to the next page.
+func listIBMISPages[T any](list func(start *string) ([]T, interface{}, *core.DetailedResponse, error)) ([]T, *core.DetailedResponse, error) {
+	var start *string
+	allrecs := []T{}
+	for {
+		items, next, response, err := list(start)
+		if err != nil {
+			return nil, response, err
+		}
+		allrecs = append(allrecs, items...)
+		token := flex.GetNext(next)
+		if token == "" {
+			break
+		}
+		start = &token
+	}
+	return allrecs, nil, nil
+}
+
+// listIBMISVPCRoutingTableRoutes returns all the routes of a routing table.
+func listIBMISVPCRoutingTableRoutes(context context.Context, sess *vpcv1.VpcV1, vpcID, tableID string) ([]vpcv1.Route, *core.DetailedResponse, error) {
+	return listIBMISPages(func(start *string) ([]vpcv1.Route, interface{}, *core.DetailedResponse, error) {
+		options := sess.NewListVPCRoutingTableRoutesOptions(vpcID, tableID)
+		options.Start = start
+		collection, response, err := sess.ListVPCRoutingTableRoutesWithContext(context, options)
+		if err != nil {
+			return nil, nil, response, err
+		}
+		return collection.Routes, collection.Next, response, nil
+	})
+}
+
+type vpcTopologyNode struct {
+	ID        string   `json:"id"`
+	Type      string   `json:"type"`
+	Name      string   `json:"name"`
+	Zone      string   `json:"zone,omitempty"`
+	CIDR      string   `json:"cidr,omitempty"`
+	Addresses []string `json:"addresses,omitempty"`
+}
+
+// vpcTopologyEdge is either a relation between two resources, or, with the
+// type "reaches", the traffic the first node can open to the second one.
+type vpcTopologyEdge struct {
+	From    string   `json:"from"`
+	To      string   `json:"to"`
+	Type    string   `json:"type"`
+	Ports   []string `json:"ports,omitempty"`
+	NextHop string   `json:"next_hop,omitempty"`
+}
+
+type vpcTopologyGraph struct {
+	VPC   string            `json:"vpc"`
+	Nodes []vpcTopologyNode `json:"nodes"`
+	Edges []vpcTopologyEdge `json:"edges"`
+}
+
+// vpcTopologyEndpoint is an address of a node, with what filters its traffic.
+type vpcTopologyEndpoint struct {
+	node           string
+	address        string
+	subnet         *vpcv1.Subnet
+	securityGroups []string
+	// Whether the endpoint opens connections, endpoint gateways only accept them
+	initiates bool
+	// Whether the endpoint can be reached from, or can reach, the internet
+	internetIngress, internetEgress bool
+}
+
+func (t *vpcTopology) graph() vpcTopologyGraph {
+	g := vpcTopologyGraph{VPC: t.vpcID, Nodes: []vpcTopologyNode{}, Edges: []vpcTopologyEdge{}}
+	link := func(from, to, edgeType string) {
+		g.Edges = append(g.Edges, vpcTopologyEdge{From: from, To: to, Type: edgeType})
+	}
+
+	subnets := map[string]*vpcv1.Subnet{}
+	for i := range t.subnets {
+		subnet := &t.subnets[i]
+		subnets[*subnet.ID] = subnet
+		g.Nodes = append(g.Nodes, vpcTopologyNode{ID: *subnet.ID, Type: "subnet", Name: *subnet.Name, Zone: *subnet.Zone.Name, CIDR: *subnet.Ipv4CIDRBlock})
+		link(*subnet.ID, *subnet.NetworkACL.ID, "filtered_by")
+		link(*subnet.ID, *subnet.RoutingTable.ID, "routed_by")
+		if subnet.PublicGateway != nil {
+			link(*subnet.ID, *subnet.PublicGateway.ID, "egress_via")
+		}
+	}
+	for _, routingTable := range t.routingTables {
+		g.Nodes = append(g.Nodes, vpcTopologyNode{ID: *routingTable.ID, Type: "routing_table", Name: *routingTable.Name})
+	}
+	networkACLRules := map[string][]networkACLRuleSpec{}
+	for _, networkACL := range t.networkACLs {
+		g.Nodes = append(g.Nodes, vpcTopologyNode{ID: *networkACL.ID, Type: "network_acl", Name: *networkACL.Name})
+		for _, nwaclRule := range networkACL.Rules {
+			if rule, ok := networkACLRuleSpecFromRule(nwaclRule); ok {
+				networkACLRules[*networkACL.ID] = append(networkACLRules[*networkACL.ID], rule)
+			}
+		}
+	}
+	securityGroupRules := map[string][]securityGroupRuleSpec{}
+	// The security groups of the network interfaces, by their ID
+	targetSecurityGroups := map[string][]string{}
+	for _, group := range t.securityGroups {
+		g.Nodes = append(g.Nodes, vpcTopologyNode{ID: *group.ID, Type: "security_group", Name: *group.Name})
+		for _, sgrule := range group.Rules {
+			if rule, ok := securityGroupRuleSpecFromRule(sgrule); ok {
+				securityGroupRules[*group.ID] = append(securityGroupRules[*group.ID], rule)
+			}
+		}
+		for _, target := range group.Targets {
+			if target, ok := target.(*vpcv1.SecurityGroupTargetReference); ok && target.ID != nil {
+				targetSecurityGroups[*target.ID] = append(targetSecurityGroups[*target.ID], *group.ID)
+			}
+		}
+	}
+	for _, publicGateway := range t.publicGateways {
+		g.Nodes = append(g.Nodes, vpcTopologyNode{ID: *publicGateway.ID, Type: "public_gateway", Name: *publicGateway.Name, Zone: *publicGateway.Zone.Name})
+	}
+	g.Nodes = append(g.Nodes, vpcTopologyNode{ID: isVPCTopologyInternet, Type: isVPCTopologyInternet, Name: isVPCTopologyInternet})
+
+	var endpoints []vpcTopologyEndpoint
+	protect := func(node string, securityGroups []string) {
+		for _, group := range securityGroups {
+			link(node, group, "protected_by")
+		}
+	}
+	securityGroupIDs := func(refs []vpcv1.SecurityGroupReference) []string {
+		ids := make([]string, 0, len(refs))
+		for _, ref := range refs {
+			ids = append(ids, *ref.ID)
+		}
+		return ids
+	}
+
+	for _, instance := range t.instances {
+		node := vpcTopologyNode{ID: *instance.ID, Type: "instance", Name: *instance.Name, Zone: *instance.Zone.Name}
+		for _, nic := range instance.NetworkInterfaces {
+			if nic.PrimaryIP == nil || nic.PrimaryIP.Address == nil {
+				continue
+			}
+			_, hasFloatingIP := t.floatingIPs[*nic.ID]
+			endpoint := vpcTopologyEndpoint{
+				node:            *instance.ID,
+				address:         *nic.PrimaryIP.Address,
+				subnet:          subnets[*nic.Subnet.ID],
+				securityGroups:  targetSecurityGroups[*nic.ID],
+				initiates:       true,
+				internetIngress: hasFloatingIP,
+				internetEgress:  hasFloatingIP,
+			}
+			node.Addresses = append(node.Addresses, endpoint.address)
+			link(*instance.ID, *nic.Subnet.ID, "attached_to")
+			protect(*instance.ID, endpoint.securityGroups)
+			endpoints = append(endpoints, endpoint)
+		}
+		g.Nodes = append(g.Nodes, node)
+	}
+
+	for _, vni := range t.virtualNetworkInterfaces {
+		node := vpcTopologyNode{ID: *vni.ID, Type: "virtual_network_interface", Name: *vni.Name, Zone: *vni.Zone.Name}
+		if vni.PrimaryIP != nil && vni.PrimaryIP.Address != nil {
+			endpoint := vpcTopologyEndpoint{
+				node:           *vni.ID,
+				address:        *vni.PrimaryIP.Address,
+				subnet:         subnets[*vni.Subnet.ID],
+				securityGroups: securityGroupIDs(vni.SecurityGroups),
+				initiates:      true,
+			}
+			node.Addresses = append(node.Addresses, endpoint.address)
+			endpoints = append(endpoints, endpoint)
+		}
+		link(*vni.ID, *vni.Subnet.ID, "attached_to")
+		protect(*vni.ID, securityGroupIDs(vni.SecurityGroups))
+		g.Nodes = append(g.Nodes, node)
+	}
+
+	for _, endpointGateway := range t.endpointGateways {
+		node := vpcTopologyNode{ID: *endpointGateway.ID, Type: "endpoint_gateway", Name: *endpointGateway.Name}
+		groups := securityGroupIDs(endpointGateway.SecurityGroups)
+		for _, ip := range endpointGateway.Ips {
+			endpoint := vpcTopologyEndpoint{
+				node:           *endpointGateway.ID,
+				address:        *ip.Address,
+				subnet:         vpcTopologySubnetOf(t.subnets, *ip.Address),
+				securityGroups: groups,
+			}
+			node.Addresses = append(node.Addresses, endpoint.address)
+			if endpoint.subnet != nil {
+				link(*endpointGateway.ID, *endpoint.subnet.ID, "attached_to")
+			}
+			endpoints = append(endpoints, endpoint)
+		}
+		protect(*endpointGateway.ID, groups)
+		g.Nodes = append(g.Nodes, node)
+	}
+
+	for _, lb := range t.loadBalancers {
+		node := vpcTopologyNode{ID: *lb.ID, Type: "load_balancer", Name: *lb.Name}
+		groups := securityGroupIDs(lb.SecurityGroups)
+		for _, ip := range lb.PrivateIps {
+			endpoint := vpcTopologyEndpoint{
+				node:            *lb.ID,
+				address:         *ip.Address,
+				subnet:          vpcTopologySubnetOf(t.subnets, *ip.Address),
+				securityGroups:  groups,
+				initiates:       true,
+				internetIngress: lb.IsPublic != nil && *lb.IsPublic,
+			}
+			node.Addresses = append(node.Addresses, endpoint.address)
+			endpoints = append(endpoints, endpoint)
+		}
+		for _, subnet := range lb.Subnets {
+			link(*lb.ID, *subnet.ID, "attached_to")
+		}
+		protect(*lb.ID, groups)
+		g.Nodes = append(g.Nodes, node)
+	}
+
+	// The traffic between two nodes is the union of the traffic between
+	// their endpoints
+	type reach struct {
+		ports    vpcTopologyPorts
+		nextHops map[string]bool
+	}
+	reaches := map[[2]string]*reach{}
+	add := func(from, to vpcTopologyEndpoint) {
+		ports, nextHop := t.allowed(from, to, securityGroupRules, networkACLRules)
+		if ports.empty() {
+			return
+		}
+		key := [2]string{from.node, to.node}
+		r, ok := reaches[key]
+		if !ok {
+			r = &reach{ports: vpcTopologyPorts{}, nextHops: map[string]bool{}}
+			reaches[key] = r
+		}
+		r.ports = r.ports.union(ports)
+		if nextHop != "" {
+			r.nextHops[nextHop] = true
+		}
+	}
+	internet := vpcTopologyEndpoint{node: isVPCTopologyInternet, address: isVPCTopologyInternetAddress}
+	for _, from := range endpoints {
+		if from.initiates {
+			for _, to := range endpoints {
+				if from.node != to.node {
+					add(from, to)
+				}
+			}
+			if from.internetEgress || (from.subnet != nil && from.subnet.PublicGateway != nil) {
+				add(from, internet)
+			}
+		}
+		if from.internetIngress {
+			add(internet, from)
+		}
+	}
+	for key, r := range reaches {
+		nextHops := make([]string, 0, len(r.nextHops))
+		for nextHop := range r.nextHops {
+			nextHops = append(nextHops, nextHop)
+		}
+		sort.Strings(nextHops)
+		g.Edges = append(g.Edges, vpcTopologyEdge{
+			From:    key[0],
+			To:      key[1],
+			Type:    "reaches",
+			Ports:   r.ports.strings(),
+			NextHop: strings.Join(nextHops, ","),
+		})
+	}
+
+	sort.Slice(g.Nodes, func(i, j int) bool {
+		if g.Nodes[i].Type != g.Nodes[j].Type {
+			return g.Nodes[i].Type < g.Nodes[j].Type
+		}
+		return g.Nodes[i].ID < g.Nodes[j].ID
+	})
+	sort.Slice(g.Edges, func(i, j int) bool {
+		a, b := g.Edges[i], g.Edges[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		return a.To < b.To
+	})
+	// An instance with several network interfaces in the same subnet is
+	// attached to it once
+	edges := g.Edges[:0]
+	for _, edge := range g.Edges {
+		if n := len(edges); n > 0 && edges[n-1].From == edge.From && edges[n-1].To == edge.To && edges[n-1].Type == edge.Type {
+			continue
+		}
+		edges = append(edges, edge)
+	}
+	g.Edges = edges
+	return g
+}
+
+// dot returns the graph in the Graphviz DOT format, the relations between
+// the resources are dashed.
+func (g vpcTopologyGraph) dot() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", g.VPC)
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&b, "  %q [label=%q];\n", node.ID, fmt.Sprintf("%s\n%s", node.Name, node.Type))
+	}
+	for _, edge := range g.Edges {
+		if edge.Type == "reaches" {
+			label := strings.Join(edge.Ports, ",")
+			if edge.NextHop != "" {
+				label = fmt.Sprintf("%s via %s", label, edge.NextHop)
+			}
+			fmt.Fprintf(&b, "  %q -> %q [label=%q];\n", edge.From, edge.To, label)
+		} else {
+			fmt.Fprintf(&b, "  %q -> %q [label=%q, style=dashed];\n", edge.From, edge.To, edge.Type)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -0,0 +1,285 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// The evaluation of the traffic between the endpoints of a VPC topology. It
+// only works on what was listed, so that it can be tested without the API.
+
+// allowed returns the traffic the security groups, the network ACLs and the
+// routes let the first endpoint open to the second one, with the next hop
+// of the route delivering it, if any.
+func (t *vpcTopology) allowed(from, to vpcTopologyEndpoint, securityGroupRules map[string][]securityGroupRuleSpec, networkACLRules map[string][]networkACLRuleSpec) (vpcTopologyPorts, string) {
+	ports := vpcTopologyAllPorts()
+	// Security groups are stateful, the responses are always allowed
+	if len(from.securityGroups) > 0 {
+		ports = ports.intersect(vpcTopologySecurityGroupPorts(from.securityGroups, securityGroupRules, "outbound", to))
+	}
+	if len(to.securityGroups) > 0 {
+		ports = ports.intersect(vpcTopologySecurityGroupPorts(to.securityGroups, securityGroupRules, "inbound", from))
+	}
+	if from.subnet != nil && to.subnet != nil && *from.subnet.ID == *to.subnet.ID {
+		// Network ACLs and routes only apply to the traffic leaving the subnet
+		return ports, ""
+	}
+	if from.subnet != nil {
+		ports = ports.intersect(vpcTopologyNetworkACLPorts(networkACLRules[*from.subnet.NetworkACL.ID], "outbound", from.address, to.address))
+	}
+	if to.subnet != nil {
+		ports = ports.intersect(vpcTopologyNetworkACLPorts(networkACLRules[*to.subnet.NetworkACL.ID], "inbound", from.address, to.address))
+	}
+
+	nextHop := ""
+	if from.subnet != nil {
+		if route := vpcTopologyRoute(t.routes[*from.subnet.RoutingTable.ID], *from.subnet.Zone.Name, to.address); route != nil {
+			switch *route.Action {
+			case "drop":
+				return vpcTopologyPorts{}, ""
+			case "deliver":
+				if hop, ok := route.NextHop.(*vpcv1.RouteNextHop); ok && hop != nil && hop.Address != nil {
+					nextHop = *hop.Address
+				}
+			}
+		}
+	}
+	return ports, nextHop
+}
+
+// vpcTopologySecurityGroupPorts returns the traffic the rules of the security
+// groups allow in the direction with the peer endpoint.
+func vpcTopologySecurityGroupPorts(securityGroups []string, securityGroupRules map[string][]securityGroupRuleSpec, direction string, peer vpcTopologyEndpoint) vpcTopologyPorts {
+	ports := vpcTopologyPorts{}
+	for _, group := range securityGroups {
+		for _, rule := range securityGroupRules[group] {
+			if rule.direction != direction || !vpcTopologyRemoteMatches(rule.remote, peer) {
+				continue
+			}
+			ports = ports.union(vpcTopologyRulePorts(rule.protocol, rule.icmpType, rule.portMin, rule.portMax))
+		}
+	}
+	return ports
+}
+
+// vpcTopologyRemoteMatches returns whether the remote of a security group
+// rule, any address, an address, a CIDR block or a security group, is the
+// endpoint.
+func vpcTopologyRemoteMatches(remote string, peer vpcTopologyEndpoint) bool {
+	if remote == "" {
+		return true
+	}
+	for _, group := range peer.securityGroups {
+		if group == remote {
+			return true
+		}
+	}
+	return networkACLAddressContains(remote, peer.address)
+}
+
+// vpcTopologyNetworkACLPorts returns the traffic the network ACL rules allow,
+// the first rule matching some traffic decides for it. The rules only
+// matching some source ports are skipped, they are for the responses.
+func vpcTopologyNetworkACLPorts(rules []networkACLRuleSpec, direction, source, destination string) vpcTopologyPorts {
+	remaining := vpcTopologyAllPorts()
+	allowed := vpcTopologyPorts{}
+	for _, rule := range rules {
+		if rule.direction != direction ||
+			!networkACLAddressContains(rule.source, source) ||
+			!networkACLAddressContains(rule.destination, destination) {
+			continue
+		}
+		if (rule.protocol == isNetworkACLRuleTCP || rule.protocol == isNetworkACLRuleUDP) &&
+			(rule.sourcePortMin > 1 || (rule.sourcePortMax != 0 && rule.sourcePortMax < 65535)) {
+			continue
+		}
+		matched := remaining.intersect(vpcTopologyRulePorts(rule.protocol, rule.icmpType, rule.portMin, rule.portMax))
+		if rule.action == "allow" {
+			allowed = allowed.union(matched)
+		}
+		remaining = remaining.subtract(matched)
+		if remaining.empty() {
+			break
+		}
+	}
+	return allowed
+}
+
+// vpcTopologyRoute returns the route of the zone for the address, with the
+// longest prefix, then the lowest priority value, or nil.
+func vpcTopologyRoute(routes []vpcv1.Route, zone, address string) *vpcv1.Route {
+	var best *vpcv1.Route
+	bestOnes := -1
+	for i := range routes {
+		route := &routes[i]
+		if route.Zone == nil || *route.Zone.Name != zone || !networkACLAddressContains(*route.Destination, address) {
+			continue
+		}
+		destination, _ := networkACLAddressNet(*route.Destination)
+		ones, _ := destination.Mask.Size()
+		if ones > bestOnes || (ones == bestOnes && route.Priority != nil && best.Priority != nil && *route.Priority < *best.Priority) {
+			best, bestOnes = route, ones
+		}
+	}
+	return best
+}
+
+// vpcTopologySubnetOf returns the subnet of the address, or nil.
+func vpcTopologySubnetOf(subnets []vpcv1.Subnet, address string) *vpcv1.Subnet {
+	for i := range subnets {
+		if networkACLAddressContains(*subnets[i].Ipv4CIDRBlock, address) {
+			return &subnets[i]
+		}
+	}
+	return nil
+}
+
+// vpcTopologyPorts is the traffic allowed by protocol, as sorted, disjoint
+// ranges of ports, or of types for ICMP.
+type vpcTopologyPorts map[string][]vpcTopologyPortRange
+
+type vpcTopologyPortRange struct {
+	min, max int64
+}
+
+var vpcTopologyProtocols = []string{isNetworkACLRuleICMP, isNetworkACLRuleTCP, isNetworkACLRuleUDP}
+
+var vpcTopologyProtocolRanges = map[string]vpcTopologyPortRange{
+	isNetworkACLRuleICMP: {0, 254},
+	isNetworkACLRuleTCP:  {1, 65535},
+	isNetworkACLRuleUDP:  {1, 65535},
+}
+
+func vpcTopologyAllPorts() vpcTopologyPorts {
+	ports := vpcTopologyPorts{}
+	for _, protocol := range vpcTopologyProtocols {
+		ports[protocol] = []vpcTopologyPortRange{vpcTopologyProtocolRanges[protocol]}
+	}
+	return ports
+}
+
+// vpcTopologyRulePorts returns the traffic matched by a rule. Unset ports
+// match all the ports.
+func vpcTopologyRulePorts(protocol string, icmpType *int64, portMin, portMax int64) vpcTopologyPorts {
+	switch protocol {
+	case isNetworkACLRuleICMP:
+		if icmpType == nil {
+			return vpcTopologyPorts{protocol: {vpcTopologyProtocolRanges[protocol]}}
+		}
+		return vpcTopologyPorts{protocol: {{*icmpType, *icmpType}}}
+	case isNetworkACLRuleTCP, isNetworkACLRuleUDP:
+		if portMin == 0 && portMax == 0 {
+			return vpcTopologyPorts{protocol: {vpcTopologyProtocolRanges[protocol]}}
+		}
+		return vpcTopologyPorts{protocol: {{portMin, portMax}}}
+	default:
+		return vpcTopologyAllPorts()
+	}
+}
+
+func (p vpcTopologyPorts) empty() bool {
+	for _, ranges := range p {
+		if len(ranges) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (p vpcTopologyPorts) union(other vpcTopologyPorts) vpcTopologyPorts {
+	result := vpcTopologyPorts{}
+	for _, protocol := range vpcTopologyProtocols {
+		ranges := append(append([]vpcTopologyPortRange{}, p[protocol]...), other[protocol]...)
+		if len(ranges) > 0 {
+			result[protocol] = vpcTopologyNormalizeRanges(ranges)
+		}
+	}
+	return result
+}
+
+func (p vpcTopologyPorts) intersect(other vpcTopologyPorts) vpcTopologyPorts {
+	result := vpcTopologyPorts{}
+	for _, protocol := range vpcTopologyProtocols {
+		var ranges []vpcTopologyPortRange
+		for _, a := range p[protocol] {
+			for _, b := range other[protocol] {
+				r := vpcTopologyPortRange{a.min, a.max}
+				if b.min > r.min {
+					r.min = b.min
+				}
+				if b.max < r.max {
+					r.max = b.max
+				}
+				if r.min <= r.max {
+					ranges = append(ranges, r)
+				}
+			}
+		}
+		if len(ranges) > 0 {
+			result[protocol] = vpcTopologyNormalizeRanges(ranges)
+		}
+	}
+	return result
+}
+
+func (p vpcTopologyPorts) subtract(other vpcTopologyPorts) vpcTopologyPorts {
+	complement := vpcTopologyPorts{}
+	for _, protocol := range vpcTopologyProtocols {
+		full := vpcTopologyProtocolRanges[protocol]
+		next := full.min
+		for _, r := range other[protocol] {
+			if r.min > next {
+				complement[protocol] = append(complement[protocol], vpcTopologyPortRange{next, r.min - 1})
+			}
+			next = r.max + 1
+		}
+		if next <= full.max {
+			complement[protocol] = append(complement[protocol], vpcTopologyPortRange{next, full.max})
+		}
+	}
+	return p.intersect(complement)
+}
+
+// strings returns the traffic as "all", or as protocols, such as "udp" for
+// all the UDP ports, with a port, a range of ports or an ICMP type, such as
+// "tcp/22", "tcp/8000-8080" or "icmp/8".
+func (p vpcTopologyPorts) strings() []string {
+	if vpcTopologyAllPorts().subtract(p).empty() {
+		return []string{"all"}
+	}
+	var result []string
+	for _, protocol := range vpcTopologyProtocols {
+		for _, r := range p[protocol] {
+			switch {
+			case r == vpcTopologyProtocolRanges[protocol]:
+				result = append(result, protocol)
+			case r.min == r.max:
+				result = append(result, fmt.Sprintf("%s/%d", protocol, r.min))
+			default:
+				result = append(result, fmt.Sprintf("%s/%d-%d", protocol, r.min, r.max))
+			}
+		}
+	}
+	return result
+}
+
+func vpcTopologyNormalizeRanges(ranges []vpcTopologyPortRange) []vpcTopologyPortRange {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].min < ranges[j].min })
+	normalized := []vpcTopologyPortRange{ranges[0]}
+	for _, r := range ranges[1:] {
+		last := &normalized[len(normalized)-1]
+		if r.min <= last.max+1 {
+			if r.max > last.max {
+				last.max = r.max
+			}
+			continue
+		}
+		normalized = append(normalized, r)
+	}
+	return normalized
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestVPCTopologyPorts(t *testing.T) {
+	tcp := func(min, max int64) vpcTopologyPorts {
+		return vpcTopologyRulePorts(isNetworkACLRuleTCP, nil, min, max)
+	}
+	cases := []struct {
+		name     string
+		ports    vpcTopologyPorts
+		expected []string
+	}{
+		{name: "union of overlapping ranges", ports: tcp(22, 80).union(tcp(50, 443)), expected: []string{"tcp/22-443"}},
+		{name: "union of adjacent ranges", ports: tcp(22, 22).union(tcp(23, 25)), expected: []string{"tcp/22-25"}},
+		{name: "union of disjoint ranges", ports: tcp(443, 443).union(tcp(22, 22)), expected: []string{"tcp/22", "tcp/443"}},
+		{name: "intersection of overlapping ranges", ports: tcp(22, 80).intersect(tcp(50, 443)), expected: []string{"tcp/50-80"}},
+		{name: "intersection of disjoint ranges", ports: tcp(22, 22).intersect(tcp(80, 80)), expected: nil},
+		{name: "intersection of protocols", ports: tcp(22, 22).intersect(vpcTopologyRulePorts(isNetworkACLRuleUDP, nil, 0, 0)), expected: nil},
+		{name: "subtraction splitting a range", ports: tcp(1, 100).subtract(tcp(50, 60)), expected: []string{"tcp/1-49", "tcp/61-100"}},
+		{name: "subtraction of a covering range", ports: tcp(50, 60).subtract(tcp(1, 100)), expected: nil},
+		{name: "all the ports of a protocol", ports: tcp(0, 0), expected: []string{"tcp"}},
+		{name: "all the traffic", ports: vpcTopologyRulePorts("all", nil, 0, 0), expected: []string{"all"}},
+		{name: "an ICMP type", ports: vpcTopologyRulePorts(isNetworkACLRuleICMP, core.Int64Ptr(8), 0, 0), expected: []string{"icmp/8"}},
+		{name: "all the ICMP types but one", ports: vpcTopologyRulePorts(isNetworkACLRuleICMP, nil, 0, 0).subtract(vpcTopologyRulePorts(isNetworkACLRuleICMP, core.Int64Ptr(8), 0, 0)), expected: []string{"icmp/0-7", "icmp/9-254"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := c.ports.strings(); !reflect.DeepEqual(actual, c.expected) {
+				t.Fatalf("expected %v, got %v", c.expected, actual)
+			}
+			if c.ports.empty() != (c.expected == nil) {
+				t.Fatalf("expected empty to be %t", c.expected == nil)
+			}
+		})
+	}
+}
+
+func TestVPCTopologyNetworkACLPorts(t *testing.T) {
+	rule := func(action, direction, protocol string, portMin, portMax int64) networkACLRuleSpec {
+		return networkACLRuleSpec{
+			action:      action,
+			direction:   direction,
+			source:      "0.0.0.0/0",
+			destination: "0.0.0.0/0",
+			protocol:    protocol,
+			portMin:     portMin,
+			portMax:     portMax,
+		}
+	}
+	icmp := func(action string, icmpType int64) networkACLRuleSpec {
+		r := rule(action, "inbound", isNetworkACLRuleICMP, 0, 0)
+		r.icmpType = &icmpType
+		return r
+	}
+	from := func(source string, r networkACLRuleSpec) networkACLRuleSpec {
+		r.source = source
+		return r
+	}
+	responses := rule("allow", "inbound", isNetworkACLRuleTCP, 0, 0)
+	responses.sourcePortMin, responses.sourcePortMax = 443, 443
+
+	cases := []struct {
+		name     string
+		rules    []networkACLRuleSpec
+		expected []string
+	}{
+		{name: "no rules", expected: nil},
+		{
+			name:     "allow before deny",
+			rules:    []networkACLRuleSpec{rule("allow", "inbound", isNetworkACLRuleTCP, 22, 22), rule("deny", "inbound", "all", 0, 0)},
+			expected: []string{"tcp/22"},
+		},
+		{
+			name:     "deny before allow",
+			rules:    []networkACLRuleSpec{rule("deny", "inbound", "all", 0, 0), rule("allow", "inbound", isNetworkACLRuleTCP, 22, 22)},
+			expected: nil,
+		},
+		{
+			name:     "overlapping ports",
+			rules:    []networkACLRuleSpec{rule("deny", "inbound", isNetworkACLRuleTCP, 50, 60), rule("allow", "inbound", isNetworkACLRuleTCP, 22, 80)},
+			expected: []string{"tcp/22-49", "tcp/61-80"},
+		},
+		{
+			name:     "allowed ports denied later",
+			rules:    []networkACLRuleSpec{rule("allow", "inbound", isNetworkACLRuleTCP, 22, 80), rule("deny", "inbound", isNetworkACLRuleTCP, 50, 60)},
+			expected: []string{"tcp/22-80"},
+		},
+		{
+			name:     "ICMP type",
+			rules:    []networkACLRuleSpec{icmp("deny", 0), icmp("allow", 8), rule("allow", "inbound", isNetworkACLRuleICMP, 0, 0)},
+			expected: []string{"icmp/1-254"},
+		},
+		{
+			name:     "other direction",
+			rules:    []networkACLRuleSpec{rule("allow", "outbound", "all", 0, 0)},
+			expected: nil,
+		},
+		{
+			name:     "other source",
+			rules:    []networkACLRuleSpec{from("192.168.0.0/16", rule("allow", "inbound", "all", 0, 0)), from("10.240.0.0/24", rule("allow", "inbound", isNetworkACLRuleUDP, 53, 53))},
+			expected: []string{"udp/53"},
+		},
+		{
+			name:     "response rule skipped",
+			rules:    []networkACLRuleSpec{responses, rule("allow", "inbound", isNetworkACLRuleTCP, 80, 80)},
+			expected: []string{"tcp/80"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := vpcTopologyNetworkACLPorts(c.rules, "inbound", "10.240.0.4", "10.240.64.4").strings()
+			if !reflect.DeepEqual(actual, c.expected) {
+				t.Fatalf("expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestVPCTopologyRoute(t *testing.T) {
+	route := func(name, zone, destination string, priority int64) vpcv1.Route {
+		return vpcv1.Route{
+			Name:        core.StringPtr(name),
+			Zone:        &vpcv1.ZoneReference{Name: core.StringPtr(zone)},
+			Destination: core.StringPtr(destination),
+			Priority:    core.Int64Ptr(priority),
+			Action:      core.StringPtr("deliver"),
+		}
+	}
+	routes := []vpcv1.Route{
+		route("default", "us-south-1", "0.0.0.0/0", 2),
+		route("wide", "us-south-1", "10.0.0.0/8", 2),
+		route("narrow", "us-south-1", "10.240.64.0/24", 2),
+		route("narrow-preferred", "us-south-1", "10.240.64.0/24", 1),
+		route("other-zone", "us-south-2", "10.240.128.0/24", 0),
+		route("host", "us-south-1", "10.240.0.5/32", 3),
+	}
+	cases := []struct {
+		name     string
+		zone     string
+		address  string
+		expected string
+	}{
+		{name: "longest prefix", zone: "us-south-1", address: "10.240.1.4", expected: "wide"},
+		{name: "lowest priority of the longest prefix", zone: "us-south-1", address: "10.240.64.4", expected: "narrow-preferred"},
+		{name: "longest prefix before priority", zone: "us-south-1", address: "10.240.0.5", expected: "host"},
+		{name: "default route", zone: "us-south-1", address: "192.168.0.1", expected: "default"},
+		{name: "routes of other zones ignored", zone: "us-south-1", address: "10.240.128.4", expected: "wide"},
+		{name: "no route", zone: "us-south-3", address: "10.240.64.4", expected: ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := ""
+			if best := vpcTopologyRoute(routes, c.zone, c.address); best != nil {
+				actual = *best.Name
+			}
+			if actual != c.expected {
+				t.Fatalf("expected route %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISVPCTopologyDataSource_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tftopology-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tftopology-subnet-%d", acctest.RandIntRange(10, 100))
+	gatewayname := fmt.Sprintf("tftopology-gw-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCTopologyDataSourceConfig(vpcname, subnetname, gatewayname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_is_vpc_topology.testacc_topology", "id", "ibm_is_vpc.testacc_vpc", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_is_vpc_topology.testacc_topology", "graph"),
+					resource.TestMatchResourceAttr(
+						"data.ibm_is_vpc_topology.testacc_topology", "dot", regexp.MustCompile(`^digraph `)),
+					testAccCheckIBMISVPCTopologyEdge(
+						"data.ibm_is_vpc_topology.testacc_topology", "ibm_is_subnet.testacc_subnet", "ibm_is_public_gateway.testacc_gw", "egress_via"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMISVPCTopologyEdge checks that the graph has an edge of the
+// type between the two resources.
+func testAccCheckIBMISVPCTopologyEdge(topology, from, to, edgeType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ids := map[string]string{}
+		for _, name := range []string{topology, from, to} {
+			rs, ok := s.RootModule().Resources[name]
+			if !ok {
+				return fmt.Errorf("Not found: %s", name)
+			}
+			ids[name] = rs.Primary.ID
+		}
+		var graph struct {
+			Edges []struct {
+				From string `json:"from"`
+				To   string `json:"to"`
+				Type string `json:"type"`
+			} `json:"edges"`
+		}
+		if err := json.Unmarshal([]byte(s.RootModule().Resources[topology].Primary.Attributes["graph"]), &graph); err != nil {
+			return err
+		}
+		for _, edge := range graph.Edges {
+			if edge.From == ids[from] && edge.To == ids[to] && edge.Type == edgeType {
+				return nil
+			}
+		}
+		return fmt.Errorf("[ERROR] No %s edge from %s to %s in the topology", edgeType, ids[from], ids[to])
+	}
+}
+
+func testAccCheckIBMISVPCTopologyDataSourceConfig(vpcname, subnetname, gatewayname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_public_gateway" "testacc_gw" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+		public_gateway  = ibm_is_public_gateway.testacc_gw.id
+	}
+
+	data "ibm_is_vpc_topology" "testacc_topology" {
+		vpc         = ibm_is_subnet.testacc_subnet.vpc
+		include_dot = true
+	}`, vpcname, gatewayname, acc.ISZoneName, subnetname, acc.ISZoneName, acc.ISCIDR)
+}
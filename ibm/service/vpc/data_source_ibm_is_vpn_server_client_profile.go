@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// vpnServerClientProfileCertKeyRegexp matches the cert and key directives of
+// the client configuration, commented out or not, which point to files.
+var vpnServerClientProfileCertKeyRegexp = regexp.MustCompile(`^#?\s*(cert|key)\s`)
+
+func DataSourceIBMIsVPNServerClientProfile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPNServerClientProfileRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpn_server": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPN server identifier.",
+			},
+			"client_certificate_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The CRN of the Secrets Manager certificate of the VPN client, issued by the client CA of the VPN server. The certificate and its private key are embedded in the profile.",
+			},
+			"profile": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The OpenVPN client profile, with the client certificate and private key embedded.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsVPNServerClientProfileRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getVPNServerClientConfigurationOptions := &vpcv1.GetVPNServerClientConfigurationOptions{}
+	getVPNServerClientConfigurationOptions.SetID(d.Get("vpn_server").(string))
+
+	result, response, err := sess.GetVPNServerClientConfigurationWithContext(context, getVPNServerClientConfigurationOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetVPNServerClientConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerClientConfigurationWithContext failed %s\n%s", err, response))
+	}
+	configStr := *result
+	configStr = strings.Trim(configStr, "\n")
+	configStr = strings.Trim(configStr, `"`)
+	configStr = strings.Replace(configStr, `\n`, "\n", -1)
+
+	profile := configStr
+	if crn, ok := d.GetOk("client_certificate_crn"); ok {
+		smClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		client, secretID, err := secretsmanager.GetClientWithSecretCRN(smClient, crn.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting the client certificate: %s", err))
+		}
+		certificate, intermediate, privateKey, err := secretsmanager.GetCertificate(context, client, secretID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting the client certificate %s: %s", crn, err))
+		}
+		if privateKey == "" {
+			return diag.FromErr(fmt.Errorf("[ERROR] The client certificate %s has no private key", crn))
+		}
+		profile = embedIBMIsVPNServerClientCertificate(configStr, certificate, intermediate, privateKey)
+	}
+
+	d.SetId(d.Get("vpn_server").(string))
+	if err = d.Set("profile", profile); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting profile: %s", err))
+	}
+	return nil
+}
+
+// embedIBMIsVPNServerClientCertificate replaces the cert and key directives
+// of the client configuration by inline cert, extra-certs and key blocks.
+func embedIBMIsVPNServerClientCertificate(config, certificate, intermediate, privateKey string) string {
+	var profile strings.Builder
+	for _, line := range strings.Split(config, "\n") {
+		if vpnServerClientProfileCertKeyRegexp.MatchString(strings.TrimSpace(line)) {
+			continue
+		}
+		profile.WriteString(line)
+		profile.WriteString("\n")
+	}
+	block := func(tag, pem string) {
+		fmt.Fprintf(&profile, "<%s>\n%s\n</%s>\n", tag, strings.TrimSpace(pem), tag)
+	}
+	block("cert", certificate)
+	if intermediate != "" {
+		block("extra-certs", intermediate)
+	}
+	block("key", privateKey)
+	return profile.String()
+}
@@ -109,7 +109,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -607,6 +607,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 					},
 				},
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isBareMetalServerTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -1312,7 +1313,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isBareMetalServerTags); ok || v != "" {
+	if _, ok := d.GetOk(isBareMetalServerTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isBareMetalServerTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *bms.CRN, "", isBareMetalServerUserTagType)
 		if err != nil {
@@ -1747,7 +1748,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			}
 			bmscrn = *bms.CRN
 		}
-		if d.HasChange(isBareMetalServerTags) {
+		if d.HasChange(isBareMetalServerTags) || d.HasChange(flex.ResourceTagsAll) {
 			oldList, newList := d.GetChange(isBareMetalServerTags)
 			err = flex.UpdateTagsUsingCRN(oldList, newList, meta, bmscrn)
 			if err != nil {
@@ -55,7 +55,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -217,6 +217,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 				Description: "Resource group info",
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isFloatingIPTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -376,7 +377,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
+	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *floatingip.CRN, "", isUserTagType)
 		if err != nil {
@@ -482,7 +483,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		return err
 	}
 
-	if d.HasChange(isFloatingIPTags) {
+	if d.HasChange(isFloatingIPTags) || d.HasChange(flex.ResourceTagsAll) {
 		options := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
@@ -53,7 +53,7 @@ func ResourceIBMISFlowLog() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -136,6 +136,7 @@ func ResourceIBMISFlowLog() *schema.Resource {
 				Description: "The lifecycle state of the flow log collector",
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isFlowLogTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -263,7 +264,7 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("Flow log collector : %s", *flowlogCollector.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" {
+	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isUserTagType)
 		if err != nil {
@@ -386,7 +387,7 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
 	}
 
-	if d.HasChange(isFlowLogTags) {
+	if d.HasChange(isFlowLogTags) || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isUserTagType)
 		if err != nil {
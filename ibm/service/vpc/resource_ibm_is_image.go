@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -68,13 +69,17 @@ func ResourceIBMISImage() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISImageLifecycleCustomizeDiff(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -119,16 +124,18 @@ func ResourceIBMISImage() *schema.Resource {
 				Description: "Set to obsolete. You can set an image to `obsolete` as a warning to transition away from soon-to-be deleted images. You can't use obsolete images to provision resources.",
 			},
 			isImageDeprecationAt: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The deprecation date and time (UTC) for this image. If absent, no deprecation date and time has been set.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: flex.SuppressEquivalentTime,
+				Description:      "The deprecation date and time (UTC) for this image. If absent, no deprecation date and time has been set.",
 			},
 			isImageObsolescenceAt: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The obsolescence date and time (UTC) for this image. If absent, no obsolescence date and time has been set.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: flex.SuppressEquivalentTime,
+				Description:      "The obsolescence date and time (UTC) for this image. If absent, no obsolescence date and time has been set.",
 			},
 
 			isImageEncryptionKey: {
@@ -137,6 +144,7 @@ func ResourceIBMISImage() *schema.Resource {
 				ForceNew:    true,
 				Description: "The CRN of the Key Protect Root Key or Hyper Protect Crypto Service Root Key for this resource",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isImageTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -225,9 +233,16 @@ func ResourceIBMISImage() *schema.Resource {
 			},
 
 			isImageCheckSum: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The SHA256 checksum of this image",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isImageVolume},
+				ValidateFunc:  validate.InvokeValidator("ibm_is_image", isImageCheckSum),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				Description: "The SHA256 checksum of this image. If set, the checksum of the imported image file is verified, and the image is deleted if they differ",
 			},
 
 			flex.ResourceStatus: {
@@ -283,10 +298,53 @@ func ResourceIBMISImageValidator() *validate.ResourceValidator {
 			Regexp:                     `^([A-Za-z0-9_.-]|[A-Za-z0-9_.-][A-Za-z0-9_ .-]*[A-Za-z0-9_.-]):([A-Za-z0-9_.-]|[A-Za-z0-9_.-][A-Za-z0-9_ .-]*[A-Za-z0-9_.-])$`,
 			MinValueLength:             1,
 			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isImageCheckSum,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^[0-9a-fA-F]+$`,
+			MinValueLength:             64,
+			MaxValueLength:             64})
 	ibmISImageResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_image", Schema: validateSchema}
 	return &ibmISImageResourceValidator
 }
 
+// resourceIBMISImageLifecycleCustomizeDiff checks that the deprecation and
+// obsolescence date and times are valid and in order, "null" removes them.
+func resourceIBMISImageLifecycleCustomizeDiff(diff *schema.ResourceDiff) error {
+	deprecationAt, err := parseIBMISImageLifecycleTime(diff, isImageDeprecationAt)
+	if err != nil {
+		return err
+	}
+	obsolescenceAt, err := parseIBMISImageLifecycleTime(diff, isImageObsolescenceAt)
+	if err != nil {
+		return err
+	}
+	if deprecationAt != nil && obsolescenceAt != nil && !obsolescenceAt.After(*deprecationAt) {
+		return fmt.Errorf("[ERROR] %s (%s) must be later than %s (%s)", isImageObsolescenceAt, obsolescenceAt.Format(time.RFC3339), isImageDeprecationAt, deprecationAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// parseIBMISImageLifecycleTime returns nil for a date and time that is unset,
+// removed or known only after apply.
+func parseIBMISImageLifecycleTime(diff *schema.ResourceDiff, key string) (*time.Time, error) {
+	if !diff.NewValueKnown(key) {
+		return nil, nil
+	}
+	value := diff.Get(key).(string)
+	if value == "" || value == "null" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] %s (%s) is not a valid RFC 3339 date and time, for example 2023-09-28T15:10:00.000Z", key, value)
+	}
+	return &t, nil
+}
+
 func resourceIBMISImageCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Image create")
@@ -364,12 +422,18 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
-	_, err = isWaitForImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	available, err := isWaitForImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
+	if checksum, ok := d.GetOk(isImageCheckSum); ok {
+		err = imgVerifyChecksum(d, meta, available.(*vpcv1.Image), checksum.(string))
+		if err != nil {
+			return err
+		}
+	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	if _, ok := d.GetOk(isImageTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
@@ -387,6 +451,25 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 	}
 	return nil
 }
+
+// imgVerifyChecksum deletes the image imported from a file whose SHA256
+// checksum is not the expected one, for example a copy of an image exported
+// from another region whose object was replaced or truncated.
+func imgVerifyChecksum(d *schema.ResourceData, meta interface{}, image *vpcv1.Image, checksum string) error {
+	var actual string
+	if image.File != nil && image.File.Checksums != nil && image.File.Checksums.Sha256 != nil {
+		actual = *image.File.Checksums.Sha256
+	}
+	if strings.EqualFold(actual, checksum) {
+		return nil
+	}
+	if err := imgDelete(d, meta, *image.ID); err != nil {
+		log.Printf("[ERROR] Error deleting Image (%s) with an unexpected checksum: %s", *image.ID, err)
+	}
+	d.SetId("")
+	return fmt.Errorf("[ERROR] Error creating Image (%s): the SHA256 checksum of the image file is %q, expected %q", *image.ID, actual, checksum)
+}
+
 func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -484,7 +567,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	if _, ok := d.GetOk(isImageTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
@@ -590,7 +673,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			}
 		}
 	}
-	if d.HasChange(isImageTags) {
+	if d.HasChange(isImageTags) || d.HasChange(flex.ResourceTagsAll) {
 		options := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
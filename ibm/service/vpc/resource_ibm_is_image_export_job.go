@@ -5,8 +5,12 @@ package vpc
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,6 +21,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
@@ -28,6 +33,11 @@ func ResourceIBMIsImageExportJob() *schema.Resource {
 		DeleteContext: ResourceIBMIsImageExportDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"image": {
 				Type:        schema.TypeString,
@@ -78,6 +88,18 @@ func ResourceIBMIsImageExportJob() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_is_image_export_job", "name"),
 				Description:  "The user-defined name for this image export job. Names must be unique within the image this export job resides in. If unspecified, the name will be a hyphenated list of randomly-selected words prefixed with the first 16 characters of the parent image name.The exported image object name in Cloud Object Storage (`storage_object.name` in the response) will be based on this name. The object name will be unique within the bucket.",
 			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for the image export job to succeed when it is created, so that the exported image object can be imported right away.",
+			},
+			"compute_storage_object_sha256": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Read the exported image object from Cloud Object Storage when the job succeeds to compute its SHA256 checksum. Requires wait_for_completion.",
+			},
 			"completed_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -161,6 +183,11 @@ func ResourceIBMIsImageExportJob() *schema.Resource {
 				Computed:    true,
 				Description: "The unique identifier for this image export job.",
 			},
+			"storage_object_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA256 checksum of the exported image object, computed when the job completes if compute_storage_object_sha256 is set.",
+			},
 		},
 	}
 }
@@ -224,6 +251,22 @@ func ResourceIBMIsImageExportCreate(context context.Context, d *schema.ResourceD
 
 	d.SetId(fmt.Sprintf("%s/%s", *createImageExportJobOptions.ImageID, *imageExportJob.ID))
 
+	if d.Get("wait_for_completion").(bool) {
+		completed, err := isWaitForImageExportJobCompleted(context, d, meta, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if d.Get("compute_storage_object_sha256").(bool) {
+			checksum, err := imageExportJobObjectSHA256(context, meta, completed.(*vpcv1.ImageExportJob))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.Set("storage_object_sha256", checksum)
+		}
+	} else if d.Get("compute_storage_object_sha256").(bool) {
+		log.Printf("[WARN] The checksum of the exported image object of image export job (%s) is only computed with wait_for_completion", d.Id())
+	}
+
 	return ResourceIBMIsImageExportRead(context, d, meta)
 }
 
@@ -459,6 +502,82 @@ func ResourceIBMIsImageExportCloudObjectStorageObjectReferenceToMap(model *vpcv1
 	return modelMap, nil
 }
 
+// isWaitForImageExportJobCompleted waits for the exported image object to be
+// complete, so that it can be imported as soon as the job is created.
+func isWaitForImageExportJobCompleted(context context.Context, d *schema.ResourceData, meta interface{}, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for image export job (%s) to be completed.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", "queued", "running"},
+		Target:     []string{"succeeded"},
+		Refresh:    isImageExportJobRefreshFunc(context, d, meta, vpcClient, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+// imageExportJobObjectSHA256 reads the exported image object from Cloud
+// Object Storage and returns its SHA256 checksum, the checksum the copies of
+// the image imported from the object have.
+func imageExportJobObjectSHA256(context context.Context, meta interface{}, imageExportJob *vpcv1.ImageExportJob) (string, error) {
+	// The location of the object is cos://<region>/<bucket>/<object>
+	if imageExportJob.StorageHref == nil || imageExportJob.StorageBucket == nil || imageExportJob.StorageBucket.CRN == nil {
+		return "", fmt.Errorf("[ERROR] Image export job (%s) has no storage location", *imageExportJob.ID)
+	}
+	location := strings.SplitN(strings.TrimPrefix(*imageExportJob.StorageHref, "cos://"), "/", 3)
+	if len(location) != 3 {
+		return "", fmt.Errorf("[ERROR] Incorrect storage location %s of image export job (%s)", *imageExportJob.StorageHref, *imageExportJob.ID)
+	}
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(*imageExportJob.StorageBucket.CRN, ":bucket:")[0])
+
+	s3Client, err := meta.(conns.ClientSession).CosS3API(location[0], instanceCRN)
+	if err != nil {
+		return "", err
+	}
+	object, err := s3Client.GetObjectWithContext(context, &s3.GetObjectInput{
+		Bucket: &location[1],
+		Key:    &location[2],
+	})
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error reading the exported image object %s: %s", *imageExportJob.StorageHref, err)
+	}
+	defer object.Body.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, object.Body); err != nil {
+		return "", fmt.Errorf("[ERROR] Error reading the exported image object %s: %s", *imageExportJob.StorageHref, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func isImageExportJobRefreshFunc(context context.Context, d *schema.ResourceData, meta interface{}, vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		parts, err := flex.SepIdParts(id, "/")
+		if err != nil {
+			return nil, "", err
+		}
+		getImgExpJobOptions := &vpcv1.GetImageExportJobOptions{}
+
+		getImgExpJobOptions.SetImageID(parts[0])
+		getImgExpJobOptions.SetID(parts[1])
+
+		imageExportJob, response, err := vpcClient.GetImageExportJobWithContext(context, getImgExpJobOptions)
+		if err != nil {
+			return imageExportJob, "", fmt.Errorf("[ERROR] Error Getting Image export job: %s\n%s", err, response)
+		}
+		if *imageExportJob.Status == "failed" {
+			reasons := make([]string, 0, len(imageExportJob.StatusReasons))
+			for _, reason := range imageExportJob.StatusReasons {
+				reasons = append(reasons, fmt.Sprintf("%s: %s", *reason.Code, *reason.Message))
+			}
+			return imageExportJob, *imageExportJob.Status, fmt.Errorf("[ERROR] Image export job (%s) failed: %s", id, strings.Join(reasons, ", "))
+		}
+		return imageExportJob, *imageExportJob.Status, nil
+	}
+}
+
 func isWaitForImageExportJobDeleted(context context.Context, d *schema.ResourceData, meta interface{}, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image export job (%s) to be deleted.", id)
 
@@ -5,6 +5,7 @@ package vpc_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -68,6 +69,27 @@ func TestAccIBMIsImageExportAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMIsImageExportWaitForCompletion(t *testing.T) {
+	var conf vpcv1.ImageExportJob
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsImageExportDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIsImageExportConfigWaitForCompletion(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsImageExportExists("ibm_is_image_export_job.is_image_export", conf),
+					resource.TestCheckResourceAttr("ibm_is_image_export_job.is_image_export", "status", "succeeded"),
+					resource.TestCheckResourceAttrSet("ibm_is_image_export_job.is_image_export", "completed_at"),
+					resource.TestMatchResourceAttr("ibm_is_image_export_job.is_image_export", "storage_object_sha256", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIsImageExportConfigBasic() string {
 	return fmt.Sprintf(`
 
@@ -80,6 +102,20 @@ func testAccCheckIBMIsImageExportConfigBasic() string {
 	`, acc.IsImage, acc.IsCosBucketName)
 }
 
+func testAccCheckIBMIsImageExportConfigWaitForCompletion() string {
+	return fmt.Sprintf(`
+
+		resource "ibm_is_image_export_job" "is_image_export" {
+			image = "%s"
+			storage_bucket {
+				name = "%s"
+			}
+			wait_for_completion = true
+			compute_storage_object_sha256 = true
+		}
+	`, acc.IsImage, acc.IsCosBucketName)
+}
+
 func testAccCheckIBMIsImageExportConfig(format string, name string) string {
 	return fmt.Sprintf(`
 
@@ -185,6 +185,40 @@ func TestAccIBMISImage_error(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISImage_lifecycle_order(t *testing.T) {
+	name := fmt.Sprintf("tfimg-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckImage(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: checkImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISImageLifecycleConfig(name, "2033-11-28T15:10:00.000Z", "2033-09-28T15:10:00.000Z"),
+				ExpectError: regexp.MustCompile("must be later than deprecation_at"),
+			},
+			{
+				Config:      testAccCheckIBMISImageLifecycleConfig(name, "2033-09-28", "2033-11-28T15:10:00.000Z"),
+				ExpectError: regexp.MustCompile("is not a valid RFC 3339 date and time"),
+			},
+		},
+	})
+}
+func TestAccIBMISImage_checksum(t *testing.T) {
+	name := fmt.Sprintf("tfimg-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckImage(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: checkImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISImageChecksumConfig(name, strings.Repeat("0", 64)),
+				ExpectError: regexp.MustCompile("the SHA256 checksum of the image file is"),
+			},
+		},
+	})
+}
 
 func TestAccIBMISImage_fromVolume(t *testing.T) {
 	var image string
@@ -299,6 +333,16 @@ func testAccCheckIBMISImageLifecycleConfig(name, deprecationAt, obsolescenceAt s
 		}
 	`, acc.Image_cos_url, name, acc.Image_operating_system, deprecationAt, obsolescenceAt)
 }
+func testAccCheckIBMISImageChecksumConfig(name, checksum string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_image" "isExampleImage" {
+			href = "%s"
+			name = "%s"
+			operating_system = "%s"
+			checksum = "%s"
+		}
+	`, acc.Image_cos_url, name, acc.Image_operating_system, checksum)
+}
 func testAccCheckIBMISImageLifecycleDeprecateConfig(name string, deprecate bool) string {
 	return fmt.Sprintf(`
 		resource "ibm_is_image" "isExampleImage" {
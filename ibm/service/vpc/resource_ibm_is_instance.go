@@ -180,12 +180,13 @@ func ResourceIBMISInstance() *schema.Resource {
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			validate.InvokeConstraintValidator("ibm_is_instance"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -304,6 +305,7 @@ func ResourceIBMISInstance() *schema.Resource {
 				Description:      "SSH key Ids for the instance",
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isInstanceTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -1008,6 +1010,13 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 	metadataServiceProtocol := "https, http"
 	validateSchema := make([]validate.ValidateSchema, 0)
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceProfile,
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			CloudDataType:              validate.CloudDataInstanceProfile})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 isInstanceMetadataServiceRespHopLimit,
@@ -1092,29 +1101,131 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
-	ibmISInstanceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance", Schema: validateSchema}
+	// An existing reserved IP keeps its own name
+	constraints := []validate.ValidateConstraint{
+		{
+			ConstraintIdentifier: validate.MutuallyExclusive,
+			Identifiers:          []string{"primary_network_interface.0.primary_ip.0.reserved_ip", "primary_network_interface.0.primary_ip.0.name"},
+		},
+	}
+
+	ibmISInstanceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance", Schema: validateSchema, Constraints: constraints}
 	return &ibmISInstanceValidator
 }
 
-func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
+// instanceCreate creates the instance from its image, catalog offering, source
+// template, boot volume snapshot or existing boot volume. Every source shares
+// the same prototype, only the source and its boot volume attachment differ.
+func instanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
 	}
-	instanceproto := &vpcv1.InstancePrototype{
-		Image: &vpcv1.ImageIdentity{
+	instanceproto, err := instancePrototypeFromResourceData(d, profile, name, vpcID, zone)
+	if err != nil {
+		return err
+	}
+
+	var prototype vpcv1.InstancePrototypeIntf = instanceproto
+	image := d.Get(isInstanceImage).(string)
+	snapshot := d.Get("boot_volume.0.snapshot").(string)
+	volume := d.Get("boot_volume.0.volume_id").(string)
+	template := d.Get(isInstanceSourceTemplate).(string)
+	if catalogOfferingOk, ok := d.GetOk(isInstanceCatalogOffering); ok {
+		catalogOffering := catalogOfferingOk.([]interface{})[0].(map[string]interface{})
+		offeringCrn, _ := catalogOffering[isInstanceCatalogOfferingOfferingCrn].(string)
+		versionCrn, _ := catalogOffering[isInstanceCatalogOfferingVersionCrn].(string)
+		if offeringCrn != "" {
+			instanceproto.CatalogOffering = &vpcv1.InstanceCatalogOfferingPrototypeCatalogOfferingByOffering{
+				Offering: &vpcv1.CatalogOfferingIdentityCatalogOfferingByCRN{
+					CRN: &offeringCrn,
+				},
+			}
+		}
+		if versionCrn != "" {
+			instanceproto.CatalogOffering = &vpcv1.InstanceCatalogOfferingPrototypeCatalogOfferingByVersion{
+				Version: &vpcv1.CatalogOfferingVersionIdentityCatalogOfferingVersionByCRN{
+					CRN: &versionCrn,
+				},
+			}
+		}
+		instanceproto.BootVolumeAttachment = instanceBootVolumeAttachmentByImage(d)
+	} else if volume != "" {
+		prototype = instancePrototypeByVolume(instanceproto, instanceBootVolumeAttachmentByVolume(d))
+	} else if snapshot != "" {
+		prototype = instancePrototypeBySourceSnapshot(instanceproto, instanceBootVolumeAttachmentBySourceSnapshot(d))
+	} else if template != "" {
+		instanceproto.SourceTemplate = &vpcv1.InstanceTemplateIdentity{
+			ID: &template,
+		}
+		instanceproto.BootVolumeAttachment = instanceBootVolumeAttachmentByImage(d)
+	} else {
+		instanceproto.Image = &vpcv1.ImageIdentity{
 			ID: &image,
-		},
-		Zone: &vpcv1.ZoneIdentity{
-			Name: &zone,
-		},
-		Profile: &vpcv1.InstanceProfileIdentity{
-			Name: &profile,
-		},
+		}
+		instanceproto.BootVolumeAttachment = instanceBootVolumeAttachmentByImage(d)
+	}
+
+	options := &vpcv1.CreateInstanceOptions{
+		InstancePrototype: prototype,
+	}
+
+	instance, response, err := sess.CreateInstance(options)
+	if err != nil {
+		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
+		return err
+	}
+	d.SetId(*instance.ID)
+
+	log.Printf("[INFO] Instance : %s", *instance.ID)
+	d.Set(isInstanceStatus, instance.Status)
+
+	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	if err != nil {
+		return err
+	}
+
+	v := os.Getenv("IC_ENV_TAGS")
+	if _, ok := d.GetOk(isInstanceTags); ok || v != "" || flex.HasDefaultTags(meta) {
+		oldList, newList := d.GetChange(isInstanceTags)
+		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
+		if err != nil {
+			log.Printf(
+				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
+		}
+	}
+	if _, ok := d.GetOk(isInstanceAccessTags); ok {
+		oldList, newList := d.GetChange(isInstanceAccessTags)
+		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
+		if err != nil {
+			log.Printf(
+				"Error on create of resource instance (%s) access tags: %s", d.Id(), err)
+		}
+	}
+	return nil
+}
+
+// instancePrototypeFromResourceData returns the prototype of the instance
+// without its source and boot volume attachment. The profile, VPC and zone can
+// be left empty when the source template provides them.
+func instancePrototypeFromResourceData(d *schema.ResourceData, profile, name, vpcID, zone string) (*vpcv1.InstancePrototype, error) {
+	instanceproto := &vpcv1.InstancePrototype{
 		Name: &name,
-		VPC: &vpcv1.VPCIdentity{
+	}
+	if profile != "" {
+		instanceproto.Profile = &vpcv1.InstanceProfileIdentity{
+			Name: &profile,
+		}
+	}
+	if vpcID != "" {
+		instanceproto.VPC = &vpcv1.VPCIdentity{
 			ID: &vpcID,
-		},
+		}
+	}
+	if zone != "" {
+		instanceproto.Zone = &vpcv1.ZoneIdentity{
+			Name: &zone,
+		}
 	}
 	if defaultTrustedProfileTargetIntf, ok := d.GetOk(isInstanceDefaultTrustedProfileTarget); ok {
 		defaultTrustedProfiletarget := defaultTrustedProfileTargetIntf.(string)
@@ -1165,137 +1276,11 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		instanceproto.PlacementTarget = placementGrp
 	}
 
-	if boot, ok := d.GetOk(isInstanceBootVolume); ok {
-		bootvol := boot.([]interface{})[0].(map[string]interface{})
-		var volTemplate = &vpcv1.VolumePrototypeInstanceByImageContext{}
-		name, ok := bootvol[isInstanceBootAttachmentName]
-		namestr := name.(string)
-		if namestr != "" && ok {
-			volTemplate.Name = &namestr
-		}
-		sizeOk, ok := bootvol[isInstanceBootSize]
-		size := sizeOk.(int)
-		if size != 0 && ok {
-			sizeInt64 := int64(size)
-			volTemplate.Capacity = &sizeInt64
-		}
-		enc, ok := bootvol[isInstanceBootEncryption]
-		encstr := enc.(string)
-		if ok && encstr != "" {
-			volTemplate.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
-				CRN: &encstr,
-			}
-		}
-
-		volprof := "general-purpose"
-		volTemplate.Profile = &vpcv1.VolumeProfileIdentity{
-			Name: &volprof,
-		}
-		var userTags *schema.Set
-		if v, ok := bootvol[isInstanceBootVolumeTags]; ok {
-			userTags = v.(*schema.Set)
-			if userTags != nil && userTags.Len() != 0 {
-				userTagsArray := make([]string, userTags.Len())
-				for i, userTag := range userTags.List() {
-					userTagStr := userTag.(string)
-					userTagsArray[i] = userTagStr
-				}
-				volTemplate.UserTags = userTagsArray
-			}
-		}
-		deleteboolIntf := bootvol[isInstanceVolAttVolAutoDelete]
-		deletebool := deleteboolIntf.(bool)
-		instanceproto.BootVolumeAttachment = &vpcv1.VolumeAttachmentPrototypeInstanceByImageContext{
-			DeleteVolumeOnInstanceDelete: &deletebool,
-			Volume:                       volTemplate,
-		}
-
-	}
-
 	if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
 		primnic := primnicintf.([]interface{})[0].(map[string]interface{})
-		subnetintf, _ := primnic[isInstanceNicSubnet]
-		subnetintfstr := subnetintf.(string)
-		var primnicobj = &vpcv1.NetworkInterfacePrototype{}
-		primnicobj.Subnet = &vpcv1.SubnetIdentity{
-			ID: &subnetintfstr,
-		}
-		name, _ := primnic[isInstanceNicName]
-		namestr := name.(string)
-		if namestr != "" {
-			primnicobj.Name = &namestr
-		}
-
-		// reserved ip changes
-
-		var ipv4str, reservedIp, reservedipv4, reservedipname string
-		var autodelete, okAuto bool
-		ipv4, _ := primnic[isInstanceNicPrimaryIpv4Address]
-		ipv4str = ipv4.(string)
-
-		primaryIpOk, ok := primnic[isInstanceNicPrimaryIP]
-		if ok && len(primaryIpOk.([]interface{})) > 0 {
-			primip := primaryIpOk.([]interface{})[0].(map[string]interface{})
-
-			reservedipok, _ := primip[isInstanceNicReservedIpId]
-			reservedIp = reservedipok.(string)
-
-			reservedipv4Ok, _ := primip[isInstanceNicReservedIpAddress]
-			reservedipv4 = reservedipv4Ok.(string)
-
-			reservedipnameOk, _ := primip[isInstanceNicReservedIpName]
-			reservedipname = reservedipnameOk.(string)
-			var reservedipautodeleteok interface{}
-			reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
-			autodelete = reservedipautodeleteok.(bool)
-		}
-		if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-			return fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-		}
-		if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
-			return fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
-		}
-		if reservedIp != "" {
-			primnicobj.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
-				ID: &reservedIp,
-			}
-		} else {
-			if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
-				primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-				if ipv4str != "" {
-					primaryipobj.Address = &ipv4str
-				}
-				if reservedipv4 != "" {
-					primaryipobj.Address = &reservedipv4
-				}
-				if reservedipname != "" {
-					primaryipobj.Name = &reservedipname
-				}
-				if okAuto {
-					primaryipobj.AutoDelete = &autodelete
-				}
-				primnicobj.PrimaryIP = primaryipobj
-			}
-		}
-
-		allowIPSpoofing, ok := primnic[isInstanceNicAllowIPSpoofing]
-		allowIPSpoofingbool := allowIPSpoofing.(bool)
-		if ok {
-			primnicobj.AllowIPSpoofing = &allowIPSpoofingbool
-		}
-		secgrpintf, ok := primnic[isInstanceNicSecurityGroups]
-		if ok {
-			secgrpSet := secgrpintf.(*schema.Set)
-			if secgrpSet.Len() != 0 {
-				var secgrpobjs = make([]vpcv1.SecurityGroupIdentityIntf, secgrpSet.Len())
-				for i, secgrpIntf := range secgrpSet.List() {
-					secgrpIntfstr := secgrpIntf.(string)
-					secgrpobjs[i] = &vpcv1.SecurityGroupIdentity{
-						ID: &secgrpIntfstr,
-					}
-				}
-				primnicobj.SecurityGroups = secgrpobjs
-			}
+		primnicobj, err := instanceNetworkInterfacePrototype(primnic, isInstancePrimaryNetworkInterface)
+		if err != nil {
+			return nil, err
 		}
 		instanceproto.PrimaryNetworkInterface = primnicobj
 	}
@@ -1304,88 +1289,9 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		nics := nicsintf.([]interface{})
 		var intfs []vpcv1.NetworkInterfacePrototype
 		for _, resource := range nics {
-			nic := resource.(map[string]interface{})
-			nwInterface := &vpcv1.NetworkInterfacePrototype{}
-			subnetintf, _ := nic[isInstanceNicSubnet]
-			subnetintfstr := subnetintf.(string)
-			nwInterface.Subnet = &vpcv1.SubnetIdentity{
-				ID: &subnetintfstr,
-			}
-			name, ok := nic[isInstanceNicName]
-			namestr := name.(string)
-			if ok && namestr != "" {
-				nwInterface.Name = &namestr
-			}
-
-			// reserved ip changes
-
-			var ipv4str, reservedIp, reservedipv4, reservedipname string
-			var autodelete, okAuto bool
-			ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
-			ipv4str = ipv4.(string)
-
-			primaryIpOk, ok := nic[isInstanceNicPrimaryIP]
-			if ok && len(primaryIpOk.([]interface{})) > 0 {
-				primip := primaryIpOk.([]interface{})[0].(map[string]interface{})
-
-				reservedipok, _ := primip[isInstanceNicReservedIpId]
-				reservedIp = reservedipok.(string)
-
-				reservedipv4Ok, _ := primip[isInstanceNicReservedIpAddress]
-				reservedipv4 = reservedipv4Ok.(string)
-
-				reservedipnameOk, _ := primip[isInstanceNicReservedIpName]
-				reservedipname = reservedipnameOk.(string)
-				var reservedipautodeleteok interface{}
-				reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
-				autodelete = reservedipautodeleteok.(bool)
-			}
-			if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-				return fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-			}
-			if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
-				return fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
-			}
-			if reservedIp != "" {
-				nwInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
-					ID: &reservedIp,
-				}
-			} else {
-				if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
-					primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-					if ipv4str != "" {
-						primaryipobj.Address = &ipv4str
-					}
-					if reservedipv4 != "" {
-						primaryipobj.Address = &reservedipv4
-					}
-					if reservedipname != "" {
-						primaryipobj.Name = &reservedipname
-					}
-					if okAuto {
-						primaryipobj.AutoDelete = &autodelete
-					}
-					nwInterface.PrimaryIP = primaryipobj
-				}
-			}
-			allowIPSpoofing, ok := nic[isInstanceNicAllowIPSpoofing]
-			allowIPSpoofingbool := allowIPSpoofing.(bool)
-			if ok {
-				nwInterface.AllowIPSpoofing = &allowIPSpoofingbool
-			}
-			secgrpintf, ok := nic[isInstanceNicSecurityGroups]
-			if ok {
-				secgrpSet := secgrpintf.(*schema.Set)
-				if secgrpSet.Len() != 0 {
-					var secgrpobjs = make([]vpcv1.SecurityGroupIdentityIntf, secgrpSet.Len())
-					for i, secgrpIntf := range secgrpSet.List() {
-						secgrpIntfstr := secgrpIntf.(string)
-						secgrpobjs[i] = &vpcv1.SecurityGroupIdentity{
-							ID: &secgrpIntfstr,
-						}
-					}
-					nwInterface.SecurityGroups = secgrpobjs
-				}
+			nwInterface, err := instanceNetworkInterfacePrototype(resource.(map[string]interface{}), isInstanceNetworkInterfaces)
+			if err != nil {
+				return nil, err
 			}
 			intfs = append(intfs, *nwInterface)
 		}
@@ -1417,1508 +1323,226 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 
 	}
 
-	metadataServiceEnabled := d.Get(isInstanceMetadataServiceEnabled).(bool)
-	if metadataServiceEnabled {
+	if metadataServiceEnabled, ok := d.GetOkExists(isInstanceMetadataServiceEnabled); ok {
+		metadataServiceEnabledBool := metadataServiceEnabled.(bool)
 		instanceproto.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
-			Enabled: &metadataServiceEnabled,
+			Enabled: &metadataServiceEnabledBool,
 		}
 	}
 
 	if metadataService := GetInstanceMetadataServiceOptions(d); metadataService != nil {
 		instanceproto.MetadataService = metadataService
 	}
+	return instanceproto, nil
+}
 
-	options := &vpcv1.CreateInstanceOptions{
-		InstancePrototype: instanceproto,
+// instanceNetworkInterfacePrototype expands a primary_network_interface or
+// network_interfaces element, attr names the one in the errors.
+func instanceNetworkInterfacePrototype(nic map[string]interface{}, attr string) (*vpcv1.NetworkInterfacePrototype, error) {
+	subnetintf, _ := nic[isInstanceNicSubnet]
+	subnetintfstr := subnetintf.(string)
+	nwInterface := &vpcv1.NetworkInterfacePrototype{
+		Subnet: &vpcv1.SubnetIdentity{
+			ID: &subnetintfstr,
+		},
 	}
-
-	instance, response, err := sess.CreateInstance(options)
-	if err != nil {
-		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
+	name, ok := nic[isInstanceNicName]
+	namestr := name.(string)
+	if ok && namestr != "" {
+		nwInterface.Name = &namestr
 	}
-	d.SetId(*instance.ID)
 
-	log.Printf("[INFO] Instance : %s", *instance.ID)
-	d.Set(isInstanceStatus, instance.Status)
+	// reserved ip changes
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
-	if err != nil {
-		return err
-	}
+	var ipv4str, reservedIp, reservedipv4, reservedipname string
+	var autodelete, okAuto bool
+	ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
+	ipv4str = ipv4.(string)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
-		if err != nil {
-			log.Printf(
-				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
+	primaryIpOk, ok := nic[isInstanceNicPrimaryIP]
+	if ok && len(primaryIpOk.([]interface{})) > 0 {
+		primip := primaryIpOk.([]interface{})[0].(map[string]interface{})
+
+		reservedipok, _ := primip[isInstanceNicReservedIpId]
+		reservedIp = reservedipok.(string)
+
+		reservedipv4Ok, _ := primip[isInstanceNicReservedIpAddress]
+		reservedipv4 = reservedipv4Ok.(string)
+
+		reservedipnameOk, _ := primip[isInstanceNicReservedIpName]
+		reservedipname = reservedipnameOk.(string)
+		var reservedipautodeleteok interface{}
+		reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
+		autodelete = reservedipautodeleteok.(bool)
+	}
+	if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
+		return nil, fmt.Errorf("[ERROR] Error creating instance, %s error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", attr, ipv4str, reservedipv4)
+	}
+	if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
+		return nil, fmt.Errorf("[ERROR] Error creating instance, %s error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", attr, reservedIp)
+	}
+	if reservedIp != "" {
+		nwInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
+			ID: &reservedIp,
+		}
+	} else {
+		if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
+			primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
+			if ipv4str != "" {
+				primaryipobj.Address = &ipv4str
+			}
+			if reservedipv4 != "" {
+				primaryipobj.Address = &reservedipv4
+			}
+			if reservedipname != "" {
+				primaryipobj.Name = &reservedipname
+			}
+			if okAuto {
+				primaryipobj.AutoDelete = &autodelete
+			}
+			nwInterface.PrimaryIP = primaryipobj
 		}
 	}
-	if _, ok := d.GetOk(isInstanceAccessTags); ok {
-		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
-		if err != nil {
-			log.Printf(
-				"Error on create of resource instance (%s) access tags: %s", d.Id(), err)
+
+	allowIPSpoofing, ok := nic[isInstanceNicAllowIPSpoofing]
+	allowIPSpoofingbool := allowIPSpoofing.(bool)
+	if ok {
+		nwInterface.AllowIPSpoofing = &allowIPSpoofingbool
+	}
+	secgrpintf, ok := nic[isInstanceNicSecurityGroups]
+	if ok {
+		secgrpSet := secgrpintf.(*schema.Set)
+		if secgrpSet.Len() != 0 {
+			var secgrpobjs = make([]vpcv1.SecurityGroupIdentityIntf, secgrpSet.Len())
+			for i, secgrpIntf := range secgrpSet.List() {
+				secgrpIntfstr := secgrpIntf.(string)
+				secgrpobjs[i] = &vpcv1.SecurityGroupIdentity{
+					ID: &secgrpIntfstr,
+				}
+			}
+			nwInterface.SecurityGroups = secgrpobjs
 		}
 	}
-	return nil
+	return nwInterface, nil
 }
-func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image, offerringCrn, versionCrn string) error {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return err
+
+// instanceBootVolumePrototype returns the name, capacity, encryption key,
+// profile and user tags of the boot volume created from an image or snapshot.
+func instanceBootVolumePrototype(bootvol map[string]interface{}) (name *string, capacity *int64, encryptionKey *vpcv1.EncryptionKeyIdentity, profile *vpcv1.VolumeProfileIdentity, userTags []string) {
+	if namestr, ok := bootvol[isInstanceBootAttachmentName].(string); ok && namestr != "" {
+		name = &namestr
 	}
-	instanceproto := &vpcv1.InstancePrototypeInstanceByCatalogOffering{
-		Zone: &vpcv1.ZoneIdentity{
-			Name: &zone,
-		},
-		Profile: &vpcv1.InstanceProfileIdentity{
-			Name: &profile,
-		},
-		Name: &name,
-		VPC: &vpcv1.VPCIdentity{
-			ID: &vpcID,
-		},
+	if size, ok := bootvol[isInstanceBootSize].(int); ok && size != 0 {
+		sizeInt64 := int64(size)
+		capacity = &sizeInt64
 	}
-	if offerringCrn != "" {
-		catalogOffering := &vpcv1.CatalogOfferingIdentityCatalogOfferingByCRN{
-			CRN: &offerringCrn,
-		}
-		offeringPrototype := &vpcv1.InstanceCatalogOfferingPrototypeCatalogOfferingByOffering{
-			Offering: catalogOffering,
+	if encstr, ok := bootvol[isInstanceBootEncryption].(string); ok && encstr != "" {
+		encryptionKey = &vpcv1.EncryptionKeyIdentity{
+			CRN: &encstr,
 		}
-		instanceproto.CatalogOffering = offeringPrototype
 	}
-	if versionCrn != "" {
-		versionOffering := &vpcv1.CatalogOfferingVersionIdentityCatalogOfferingVersionByCRN{
-			CRN: &versionCrn,
-		}
-		versionPrototype := &vpcv1.InstanceCatalogOfferingPrototypeCatalogOfferingByVersion{
-			Version: versionOffering,
+	volprof := "general-purpose"
+	profile = &vpcv1.VolumeProfileIdentity{
+		Name: &volprof,
+	}
+	if v, ok := bootvol[isInstanceBootVolumeTags]; ok {
+		tags := v.(*schema.Set)
+		if tags != nil && tags.Len() != 0 {
+			userTags = make([]string, tags.Len())
+			for i, userTag := range tags.List() {
+				userTags[i] = userTag.(string)
+			}
 		}
-		instanceproto.CatalogOffering = versionPrototype
 	}
-	if defaultTrustedProfileTargetIntf, ok := d.GetOk(isInstanceDefaultTrustedProfileTarget); ok {
-		defaultTrustedProfiletarget := defaultTrustedProfileTargetIntf.(string)
+	return
+}
 
-		target := &vpcv1.TrustedProfileIdentity{}
-		if strings.HasPrefix(defaultTrustedProfiletarget, "crn") {
-			target.CRN = &defaultTrustedProfiletarget
-		} else {
-			target.ID = &defaultTrustedProfiletarget
-		}
-		instanceproto.DefaultTrustedProfile = &vpcv1.InstanceDefaultTrustedProfilePrototype{
-			Target: target,
-		}
+func instanceBootVolumeAttachmentByImage(d *schema.ResourceData) *vpcv1.VolumeAttachmentPrototypeInstanceByImageContext {
+	boot, ok := d.GetOk(isInstanceBootVolume)
+	if !ok {
+		return nil
+	}
+	bootvol := boot.([]interface{})[0].(map[string]interface{})
+	volTemplate := &vpcv1.VolumePrototypeInstanceByImageContext{}
+	volTemplate.Name, volTemplate.Capacity, volTemplate.EncryptionKey, volTemplate.Profile, volTemplate.UserTags = instanceBootVolumePrototype(bootvol)
+	deletebool := bootvol[isInstanceVolAttVolAutoDelete].(bool)
+	return &vpcv1.VolumeAttachmentPrototypeInstanceByImageContext{
+		DeleteVolumeOnInstanceDelete: &deletebool,
+		Volume:                       volTemplate,
+	}
+}
 
-		if defaultTrustedProfileAutoLinkIntf, ok := d.GetOkExists(isInstanceDefaultTrustedProfileAutoLink); ok {
-			defaultTrustedProfileAutoLink := defaultTrustedProfileAutoLinkIntf.(bool)
-			instanceproto.DefaultTrustedProfile.AutoLink = &defaultTrustedProfileAutoLink
+func instanceBootVolumeAttachmentBySourceSnapshot(d *schema.ResourceData) *vpcv1.VolumeAttachmentPrototypeInstanceBySourceSnapshotContext {
+	bootvol := d.Get(isInstanceBootVolume).([]interface{})[0].(map[string]interface{})
+	volTemplate := &vpcv1.VolumePrototypeInstanceBySourceSnapshotContext{}
+	volTemplate.Name, volTemplate.Capacity, volTemplate.EncryptionKey, volTemplate.Profile, volTemplate.UserTags = instanceBootVolumePrototype(bootvol)
+	if snapshotIdStr, ok := bootvol[isInstanceVolumeSnapshot].(string); ok && snapshotIdStr != "" {
+		volTemplate.SourceSnapshot = &vpcv1.SnapshotIdentity{
+			ID: &snapshotIdStr,
 		}
 	}
-	if availablePolicyItem, ok := d.GetOk(isInstanceAvailablePolicyHostFailure); ok {
-		hostFailure := availablePolicyItem.(string)
-		instanceproto.AvailabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPrototype{
-			HostFailure: &hostFailure,
-		}
+	deletebool := bootvol[isInstanceVolAttVolAutoDelete].(bool)
+	return &vpcv1.VolumeAttachmentPrototypeInstanceBySourceSnapshotContext{
+		DeleteVolumeOnInstanceDelete: &deletebool,
+		Volume:                       volTemplate,
 	}
+}
 
-	if totalVolBandwidthIntf, ok := d.GetOk(isInstanceTotalVolumeBandwidth); ok {
-		totalVolBandwidthStr := int64(totalVolBandwidthIntf.(int))
-		instanceproto.TotalVolumeBandwidth = &totalVolBandwidthStr
+func instanceBootVolumeAttachmentByVolume(d *schema.ResourceData) *vpcv1.VolumeAttachmentPrototypeInstanceByVolumeContext {
+	bootvol := d.Get(isInstanceBootVolume).([]interface{})[0].(map[string]interface{})
+	bootVolAttachment := &vpcv1.VolumeAttachmentPrototypeInstanceByVolumeContext{}
+	if volumeIdStr, ok := bootvol[isInstanceBootVolumeId].(string); ok && volumeIdStr != "" {
+		bootVolAttachment.Volume = &vpcv1.VolumeIdentity{
+			ID: &volumeIdStr,
+		}
 	}
-	if dHostIdInf, ok := d.GetOk(isPlacementTargetDedicatedHost); ok {
-		dHostIdStr := dHostIdInf.(string)
-		dHostPlaementTarget := &vpcv1.InstancePlacementTargetPrototypeDedicatedHostIdentity{
-			ID: &dHostIdStr,
-		}
-		instanceproto.PlacementTarget = dHostPlaementTarget
-	} else if dHostGrpIdInf, ok := d.GetOk(isPlacementTargetDedicatedHostGroup); ok {
-		dHostGrpIdStr := dHostGrpIdInf.(string)
-		dHostGrpPlaementTarget := &vpcv1.InstancePlacementTargetPrototypeDedicatedHostGroupIdentity{
-			ID: &dHostGrpIdStr,
-		}
-		instanceproto.PlacementTarget = dHostGrpPlaementTarget
-	} else if placementGroupInf, ok := d.GetOk(isPlacementTargetPlacementGroup); ok {
-		placementGrpStr := placementGroupInf.(string)
-		placementGrp := &vpcv1.InstancePlacementTargetPrototypePlacementGroupIdentity{
-			ID: &placementGrpStr,
-		}
-		instanceproto.PlacementTarget = placementGrp
-	}
-
-	if boot, ok := d.GetOk(isInstanceBootVolume); ok {
-		bootvol := boot.([]interface{})[0].(map[string]interface{})
-		var volTemplate = &vpcv1.VolumePrototypeInstanceByImageContext{}
-		name, ok := bootvol[isInstanceBootAttachmentName]
-		namestr := name.(string)
-		if namestr != "" && ok {
-			volTemplate.Name = &namestr
-		}
-		sizeOk, ok := bootvol[isInstanceBootSize]
-		size := sizeOk.(int)
-		if size != 0 && ok {
-			sizeInt64 := int64(size)
-			volTemplate.Capacity = &sizeInt64
-		}
-		enc, ok := bootvol[isInstanceBootEncryption]
-		encstr := enc.(string)
-		if ok && encstr != "" {
-			volTemplate.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
-				CRN: &encstr,
-			}
-		}
-
-		volprof := "general-purpose"
-		volTemplate.Profile = &vpcv1.VolumeProfileIdentity{
-			Name: &volprof,
-		}
-		deleteboolIntf := bootvol[isInstanceVolAttVolAutoDelete]
-		deletebool := deleteboolIntf.(bool)
-		instanceproto.BootVolumeAttachment = &vpcv1.VolumeAttachmentPrototypeInstanceByImageContext{
-			DeleteVolumeOnInstanceDelete: &deletebool,
-			Volume:                       volTemplate,
-		}
-
-	}
-
-	if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
-		primnic := primnicintf.([]interface{})[0].(map[string]interface{})
-		subnetintf, _ := primnic[isInstanceNicSubnet]
-		subnetintfstr := subnetintf.(string)
-		var primnicobj = &vpcv1.NetworkInterfacePrototype{}
-		primnicobj.Subnet = &vpcv1.SubnetIdentity{
-			ID: &subnetintfstr,
-		}
-		name, _ := primnic[isInstanceNicName]
-		namestr := name.(string)
-		if namestr != "" {
-			primnicobj.Name = &namestr
-		}
-
-		// reserved ip changes
-
-		var ipv4str, reservedIp, reservedipv4, reservedipname string
-		var autodelete, okAuto bool
-		ipv4, _ := primnic[isInstanceNicPrimaryIpv4Address]
-		ipv4str = ipv4.(string)
-
-		primaryIpOk, ok := primnic[isInstanceNicPrimaryIP]
-		if ok && len(primaryIpOk.([]interface{})) > 0 {
-			primip := primaryIpOk.([]interface{})[0].(map[string]interface{})
-
-			reservedipok, _ := primip[isInstanceNicReservedIpId]
-			reservedIp = reservedipok.(string)
-
-			reservedipv4Ok, _ := primip[isInstanceNicReservedIpAddress]
-			reservedipv4 = reservedipv4Ok.(string)
-
-			reservedipnameOk, _ := primip[isInstanceNicReservedIpName]
-			reservedipname = reservedipnameOk.(string)
-			var reservedipautodeleteok interface{}
-			reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
-			autodelete = reservedipautodeleteok.(bool)
-		}
-		if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-			return fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-		}
-		if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
-			return fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
-		}
-		if reservedIp != "" {
-			primnicobj.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
-				ID: &reservedIp,
-			}
-		} else {
-			if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
-				primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-				if ipv4str != "" {
-					primaryipobj.Address = &ipv4str
-				}
-				if reservedipv4 != "" {
-					primaryipobj.Address = &reservedipv4
-				}
-				if reservedipname != "" {
-					primaryipobj.Name = &reservedipname
-				}
-				if okAuto {
-					primaryipobj.AutoDelete = &autodelete
-				}
-				primnicobj.PrimaryIP = primaryipobj
-			}
-		}
-
-		allowIPSpoofing, ok := primnic[isInstanceNicAllowIPSpoofing]
-		allowIPSpoofingbool := allowIPSpoofing.(bool)
-		if ok {
-			primnicobj.AllowIPSpoofing = &allowIPSpoofingbool
-		}
-		secgrpintf, ok := primnic[isInstanceNicSecurityGroups]
-		if ok {
-			secgrpSet := secgrpintf.(*schema.Set)
-			if secgrpSet.Len() != 0 {
-				var secgrpobjs = make([]vpcv1.SecurityGroupIdentityIntf, secgrpSet.Len())
-				for i, secgrpIntf := range secgrpSet.List() {
-					secgrpIntfstr := secgrpIntf.(string)
-					secgrpobjs[i] = &vpcv1.SecurityGroupIdentity{
-						ID: &secgrpIntfstr,
-					}
-				}
-				primnicobj.SecurityGroups = secgrpobjs
-			}
-		}
-		instanceproto.PrimaryNetworkInterface = primnicobj
-	}
-
-	if nicsintf, ok := d.GetOk(isInstanceNetworkInterfaces); ok {
-		nics := nicsintf.([]interface{})
-		var intfs []vpcv1.NetworkInterfacePrototype
-		for _, resource := range nics {
-			nic := resource.(map[string]interface{})
-			nwInterface := &vpcv1.NetworkInterfacePrototype{}
-			subnetintf, _ := nic[isInstanceNicSubnet]
-			subnetintfstr := subnetintf.(string)
-			nwInterface.Subnet = &vpcv1.SubnetIdentity{
-				ID: &subnetintfstr,
-			}
-			name, ok := nic[isInstanceNicName]
-			namestr := name.(string)
-			if ok && namestr != "" {
-				nwInterface.Name = &namestr
-			}
-
-			// reserved ip changes
-
-			var ipv4str, reservedIp, reservedipv4, reservedipname string
-			var autodelete, okAuto bool
-			ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
-			ipv4str = ipv4.(string)
-
-			primaryIpOk, ok := nic[isInstanceNicPrimaryIP]
-			if ok && len(primaryIpOk.([]interface{})) > 0 {
-				primip := primaryIpOk.([]interface{})[0].(map[string]interface{})
-
-				reservedipok, _ := primip[isInstanceNicReservedIpId]
-				reservedIp = reservedipok.(string)
-
-				reservedipv4Ok, _ := primip[isInstanceNicReservedIpAddress]
-				reservedipv4 = reservedipv4Ok.(string)
-
-				reservedipnameOk, _ := primip[isInstanceNicReservedIpName]
-				reservedipname = reservedipnameOk.(string)
-				var reservedipautodeleteok interface{}
-				reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
-				autodelete = reservedipautodeleteok.(bool)
-			}
-			if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-				return fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-			}
-			if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
-				return fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
-			}
-			if reservedIp != "" {
-				nwInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
-					ID: &reservedIp,
-				}
-			} else {
-				if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
-					primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-					if ipv4str != "" {
-						primaryipobj.Address = &ipv4str
-					}
-					if reservedipv4 != "" {
-						primaryipobj.Address = &reservedipv4
-					}
-					if reservedipname != "" {
-						primaryipobj.Name = &reservedipname
-					}
-					if okAuto {
-						primaryipobj.AutoDelete = &autodelete
-					}
-					nwInterface.PrimaryIP = primaryipobj
-				}
-			}
-			allowIPSpoofing, ok := nic[isInstanceNicAllowIPSpoofing]
-			allowIPSpoofingbool := allowIPSpoofing.(bool)
-			if ok {
-				nwInterface.AllowIPSpoofing = &allowIPSpoofingbool
-			}
-			secgrpintf, ok := nic[isInstanceNicSecurityGroups]
-			if ok {
-				secgrpSet := secgrpintf.(*schema.Set)
-				if secgrpSet.Len() != 0 {
-					var secgrpobjs = make([]vpcv1.SecurityGroupIdentityIntf, secgrpSet.Len())
-					for i, secgrpIntf := range secgrpSet.List() {
-						secgrpIntfstr := secgrpIntf.(string)
-						secgrpobjs[i] = &vpcv1.SecurityGroupIdentity{
-							ID: &secgrpIntfstr,
-						}
-					}
-					nwInterface.SecurityGroups = secgrpobjs
-				}
-			}
-			intfs = append(intfs, *nwInterface)
-		}
-		instanceproto.NetworkInterfaces = intfs
-	}
-
-	keySet := d.Get(isInstanceKeys).(*schema.Set)
-	if keySet.Len() != 0 {
-		keyobjs := make([]vpcv1.KeyIdentityIntf, keySet.Len())
-		for i, key := range keySet.List() {
-			keystr := key.(string)
-			keyobjs[i] = &vpcv1.KeyIdentity{
-				ID: &keystr,
-			}
-		}
-		instanceproto.Keys = keyobjs
-	}
-
-	if userdata, ok := d.GetOk(isInstanceUserData); ok {
-		userdatastr := userdata.(string)
-		instanceproto.UserData = &userdatastr
-	}
-
-	if grp, ok := d.GetOk(isInstanceResourceGroup); ok {
-		grpstr := grp.(string)
-		instanceproto.ResourceGroup = &vpcv1.ResourceGroupIdentity{
-			ID: &grpstr,
-		}
-
-	}
-
-	metadataServiceEnabled := d.Get(isInstanceMetadataServiceEnabled).(bool)
-	if metadataServiceEnabled {
-		instanceproto.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
-			Enabled: &metadataServiceEnabled,
-		}
-	}
-
-	if metadataService := GetInstanceMetadataServiceOptions(d); metadataService != nil {
-		instanceproto.MetadataService = metadataService
-	}
-
-	options := &vpcv1.CreateInstanceOptions{
-		InstancePrototype: instanceproto,
-	}
-
-	instance, response, err := sess.CreateInstance(options)
-	if err != nil {
-		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
-	}
-	d.SetId(*instance.ID)
-
-	log.Printf("[INFO] Instance : %s", *instance.ID)
-	d.Set(isInstanceStatus, instance.Status)
-
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
-	if err != nil {
-		return err
-	}
-
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
-		if err != nil {
-			log.Printf(
-				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
-		}
-	}
-	return nil
-}
-
-func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image, template string) error {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return err
-	}
-	instanceproto := &vpcv1.InstancePrototypeInstanceBySourceTemplate{
-		SourceTemplate: &vpcv1.InstanceTemplateIdentity{
-			ID: &template,
-		},
-		Name: &name,
-	}
-
-	if defaultTrustedProfileTargetIntf, ok := d.GetOk(isInstanceDefaultTrustedProfileTarget); ok {
-		defaultTrustedProfiletarget := defaultTrustedProfileTargetIntf.(string)
-
-		target := &vpcv1.TrustedProfileIdentity{}
-		if strings.HasPrefix(defaultTrustedProfiletarget, "crn") {
-			target.CRN = &defaultTrustedProfiletarget
-		} else {
-			target.ID = &defaultTrustedProfiletarget
-		}
-		instanceproto.DefaultTrustedProfile = &vpcv1.InstanceDefaultTrustedProfilePrototype{
-			Target: target,
-		}
-
-		if defaultTrustedProfileAutoLinkIntf, ok := d.GetOkExists(isInstanceDefaultTrustedProfileAutoLink); ok {
-			defaultTrustedProfileAutoLink := defaultTrustedProfileAutoLinkIntf.(bool)
-			instanceproto.DefaultTrustedProfile.AutoLink = &defaultTrustedProfileAutoLink
-		}
-	}
-	if profile != "" {
-		instanceproto.Profile = &vpcv1.InstanceProfileIdentity{
-			Name: &profile,
-		}
-	}
-	if totalVolBandwidthIntf, ok := d.GetOk(isInstanceTotalVolumeBandwidth); ok {
-		totalVolBandwidthStr := int64(totalVolBandwidthIntf.(int))
-		instanceproto.TotalVolumeBandwidth = &totalVolBandwidthStr
-	}
-
-	if vpcID != "" {
-		instanceproto.VPC = &vpcv1.VPCIdentity{
-			ID: &vpcID,
-		}
-	}
-	if zone != "" {
-		instanceproto.Zone = &vpcv1.ZoneIdentity{
-			Name: &zone,
-		}
-	}
-
-	if dHostIdInf, ok := d.GetOk(isPlacementTargetDedicatedHost); ok {
-		dHostIdStr := dHostIdInf.(string)
-		dHostPlaementTarget := &vpcv1.InstancePlacementTargetPrototypeDedicatedHostIdentity{
-			ID: &dHostIdStr,
-		}
-		instanceproto.PlacementTarget = dHostPlaementTarget
-	} else if dHostGrpIdInf, ok := d.GetOk(isPlacementTargetDedicatedHostGroup); ok {
-		dHostGrpIdStr := dHostGrpIdInf.(string)
-		dHostGrpPlaementTarget := &vpcv1.InstancePlacementTargetPrototypeDedicatedHostGroupIdentity{
-			ID: &dHostGrpIdStr,
-		}
-		instanceproto.PlacementTarget = dHostGrpPlaementTarget
-	} else if placementGroupInf, ok := d.GetOk(isPlacementTargetPlacementGroup); ok {
-		placementGrpStr := placementGroupInf.(string)
-		placementGrp := &vpcv1.InstancePlacementTargetPrototypePlacementGroupIdentity{
-			ID: &placementGrpStr,
-		}
-		instanceproto.PlacementTarget = placementGrp
-	}
-	if availablePolicyItem, ok := d.GetOk(isInstanceAvailablePolicyHostFailure); ok {
-		hostFailure := availablePolicyItem.(string)
-		instanceproto.AvailabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPrototype{
-			HostFailure: &hostFailure,
-		}
-	}
-	if boot, ok := d.GetOk(isInstanceBootVolume); ok {
-		bootvol := boot.([]interface{})[0].(map[string]interface{})
-		var volTemplate = &vpcv1.VolumePrototypeInstanceByImageContext{}
-		name, ok := bootvol[isInstanceBootAttachmentName]
-		namestr := name.(string)
-		if namestr != "" && ok {
-			volTemplate.Name = &namestr
-		}
-		sizeOk, ok := bootvol[isInstanceBootSize]
-		size := sizeOk.(int)
-		if size != 0 && ok {
-			sizeInt64 := int64(size)
-			volTemplate.Capacity = &sizeInt64
-		}
-		enc, ok := bootvol[isInstanceBootEncryption]
-		encstr := enc.(string)
-		if ok && encstr != "" {
-			volTemplate.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
-				CRN: &encstr,
-			}
-		}
-
-		volprof := "general-purpose"
-
-		volTemplate.Profile = &vpcv1.VolumeProfileIdentity{
-			Name: &volprof,
-		}
-		var userTags *schema.Set
-		if v, ok := bootvol[isInstanceBootVolumeTags]; ok {
-			userTags = v.(*schema.Set)
-			if userTags != nil && userTags.Len() != 0 {
-				userTagsArray := make([]string, userTags.Len())
-				for i, userTag := range userTags.List() {
-					userTagStr := userTag.(string)
-					userTagsArray[i] = userTagStr
-				}
-				volTemplate.UserTags = userTagsArray
-			}
-		}
-		deleteboolIntf := bootvol[isInstanceVolAttVolAutoDelete]
-		deletebool := deleteboolIntf.(bool)
-
-		instanceproto.BootVolumeAttachment = &vpcv1.VolumeAttachmentPrototypeInstanceByImageContext{
-			DeleteVolumeOnInstanceDelete: &deletebool,
-			Volume:                       volTemplate,
-		}
-	}
-
-	if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
-		primnic := primnicintf.([]interface{})[0].(map[string]interface{})
-		subnetintf, _ := primnic[isInstanceNicSubnet]
-		subnetintfstr := subnetintf.(string)
-		var primnicobj = &vpcv1.NetworkInterfacePrototype{}
-		primnicobj.Subnet = &vpcv1.SubnetIdentity{
-			ID: &subnetintfstr,
-		}
-		name, _ := primnic[isInstanceNicName]
-		namestr := name.(string)
-		if namestr != "" {
-			primnicobj.Name = &namestr
-		}
-
-		// reserved ip changes
-
-		var ipv4str, reservedIp, reservedipv4, reservedipname string
-		var autodelete, okAuto bool
-		ipv4, _ := primnic[isInstanceNicPrimaryIpv4Address]
-		ipv4str = ipv4.(string)
-
-		primaryIpOk, ok := primnic[isInstanceNicPrimaryIP]
-		if ok && len(primaryIpOk.([]interface{})) > 0 {
-			primip := primaryIpOk.([]interface{})[0].(map[string]interface{})
-
-			reservedipok, _ := primip[isInstanceNicReservedIpId]
-			reservedIp = reservedipok.(string)
-
-			reservedipv4Ok, _ := primip[isInstanceNicReservedIpAddress]
-			reservedipv4 = reservedipv4Ok.(string)
-
-			reservedipnameOk, _ := primip[isInstanceNicReservedIpName]
-			reservedipname = reservedipnameOk.(string)
-			var reservedipautodeleteok interface{}
-			reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
-			autodelete = reservedipautodeleteok.(bool)
-		}
-		if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-			return fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-		}
-		if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
-			return fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
-		}
-		if reservedIp != "" {
-			primnicobj.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
-				ID: &reservedIp,
-			}
-		} else {
-			if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
-				primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-				if ipv4str != "" {
-					primaryipobj.Address = &ipv4str
-				}
-				if reservedipv4 != "" {
-					primaryipobj.Address = &reservedipv4
-				}
-				if reservedipname != "" {
-					primaryipobj.Name = &reservedipname
-				}
-				if okAuto {
-					primaryipobj.AutoDelete = &autodelete
-				}
-				primnicobj.PrimaryIP = primaryipobj
-			}
-		}
-		allowIPSpoofing, ok := primnic[isInstanceNicAllowIPSpoofing]
-		allowIPSpoofingbool := allowIPSpoofing.(bool)
-		if ok {
-			primnicobj.AllowIPSpoofing = &allowIPSpoofingbool
-		}
-		secgrpintf, ok := primnic[isInstanceNicSecurityGroups]
-		if ok {
-			secgrpSet := secgrpintf.(*schema.Set)
-			if secgrpSet.Len() != 0 {
-				var secgrpobjs = make([]vpcv1.SecurityGroupIdentityIntf, secgrpSet.Len())
-				for i, secgrpIntf := range secgrpSet.List() {
-					secgrpIntfstr := secgrpIntf.(string)
-					secgrpobjs[i] = &vpcv1.SecurityGroupIdentity{
-						ID: &secgrpIntfstr,
-					}
-				}
-				primnicobj.SecurityGroups = secgrpobjs
-			}
-		}
-		instanceproto.PrimaryNetworkInterface = primnicobj
-	}
-
-	if nicsintf, ok := d.GetOk(isInstanceNetworkInterfaces); ok {
-		nics := nicsintf.([]interface{})
-		var intfs []vpcv1.NetworkInterfacePrototype
-		for _, resource := range nics {
-			nic := resource.(map[string]interface{})
-			nwInterface := &vpcv1.NetworkInterfacePrototype{}
-			subnetintf, _ := nic[isInstanceNicSubnet]
-			subnetintfstr := subnetintf.(string)
-			nwInterface.Subnet = &vpcv1.SubnetIdentity{
-				ID: &subnetintfstr,
-			}
-			name, ok := nic[isInstanceNicName]
-			namestr := name.(string)
-			if ok && namestr != "" {
-				nwInterface.Name = &namestr
-			}
-
-			// reserved ip changes
-
-			var ipv4str, reservedIp, reservedipv4, reservedipname string
-			var autodelete, okAuto bool
-			ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
-			ipv4str = ipv4.(string)
-
-			primaryIpOk, ok := nic[isInstanceNicPrimaryIP]
-			if ok && len(primaryIpOk.([]interface{})) > 0 {
-				primip := primaryIpOk.([]interface{})[0].(map[string]interface{})
-
-				reservedipok, _ := primip[isInstanceNicReservedIpId]
-				reservedIp = reservedipok.(string)
-
-				reservedipv4Ok, _ := primip[isInstanceNicReservedIpAddress]
-				reservedipv4 = reservedipv4Ok.(string)
-
-				reservedipnameOk, _ := primip[isInstanceNicReservedIpName]
-				reservedipname = reservedipnameOk.(string)
-				var reservedipautodeleteok interface{}
-				reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
-				autodelete = reservedipautodeleteok.(bool)
-			}
-			if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-				return fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-			}
-			if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
-				return fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
-			}
-			if reservedIp != "" {
-				nwInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
-					ID: &reservedIp,
-				}
-			} else {
-				if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
-					primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-					if ipv4str != "" {
-						primaryipobj.Address = &ipv4str
-					}
-					if reservedipv4 != "" {
-						primaryipobj.Address = &reservedipv4
-					}
-					if reservedipname != "" {
-						primaryipobj.Name = &reservedipname
-					}
-					if okAuto {
-						primaryipobj.AutoDelete = &autodelete
-					}
-					nwInterface.PrimaryIP = primaryipobj
-				}
-			}
-			allowIPSpoofing, ok := nic[isInstanceNicAllowIPSpoofing]
-			allowIPSpoofingbool := allowIPSpoofing.(bool)
-			if ok {
-				nwInterface.AllowIPSpoofing = &allowIPSpoofingbool
-			}
-			secgrpintf, ok := nic[isInstanceNicSecurityGroups]
-			if ok {
-				secgrpSet := secgrpintf.(*schema.Set)
-				if secgrpSet.Len() != 0 {
-					var secgrpobjs = make([]vpcv1.SecurityGroupIdentityIntf, secgrpSet.Len())
-					for i, secgrpIntf := range secgrpSet.List() {
-						secgrpIntfstr := secgrpIntf.(string)
-						secgrpobjs[i] = &vpcv1.SecurityGroupIdentity{
-							ID: &secgrpIntfstr,
-						}
-					}
-					nwInterface.SecurityGroups = secgrpobjs
-				}
-			}
-			intfs = append(intfs, *nwInterface)
-		}
-		instanceproto.NetworkInterfaces = intfs
-	}
-
-	keySet := d.Get(isInstanceKeys).(*schema.Set)
-	if keySet.Len() != 0 {
-		keyobjs := make([]vpcv1.KeyIdentityIntf, keySet.Len())
-		for i, key := range keySet.List() {
-			keystr := key.(string)
-			keyobjs[i] = &vpcv1.KeyIdentity{
-				ID: &keystr,
-			}
-		}
-		instanceproto.Keys = keyobjs
-	}
-
-	if userdata, ok := d.GetOk(isInstanceUserData); ok {
-		userdatastr := userdata.(string)
-		instanceproto.UserData = &userdatastr
-	}
-
-	if grp, ok := d.GetOk(isInstanceResourceGroup); ok {
-		grpstr := grp.(string)
-		instanceproto.ResourceGroup = &vpcv1.ResourceGroupIdentity{
-			ID: &grpstr,
-		}
-
-	}
-
-	if metadataServiceEnabled, ok := d.GetOkExists(isInstanceMetadataServiceEnabled); ok {
-		metadataServiceEnabledBool := metadataServiceEnabled.(bool)
-		instanceproto.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
-			Enabled: &metadataServiceEnabledBool,
-		}
-	}
-
-	if metadataService := GetInstanceMetadataServiceOptions(d); metadataService != nil {
-		instanceproto.MetadataService = metadataService
-	}
-
-	options := &vpcv1.CreateInstanceOptions{
-		InstancePrototype: instanceproto,
-	}
-
-	instance, response, err := sess.CreateInstance(options)
-	if err != nil {
-		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
-	}
-	d.SetId(*instance.ID)
-
-	log.Printf("[INFO] Instance : %s", *instance.ID)
-	d.Set(isInstanceStatus, instance.Status)
-
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
-	if err != nil {
-		return err
-	}
-
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
-		if err != nil {
-			log.Printf(
-				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
-		}
-	}
-	if _, ok := d.GetOk(isInstanceAccessTags); ok {
-		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
-		if err != nil {
-			log.Printf(
-				"Error on create of resource instance (%s) access tags: %s", d.Id(), err)
-		}
-	}
-	return nil
-}
-
-func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone string) error {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return err
-	}
-	instanceproto := &vpcv1.InstancePrototypeInstanceBySourceSnapshot{
-		Zone: &vpcv1.ZoneIdentity{
-			Name: &zone,
-		},
-		Profile: &vpcv1.InstanceProfileIdentity{
-			Name: &profile,
-		},
-		Name: &name,
-		VPC: &vpcv1.VPCIdentity{
-			ID: &vpcID,
-		},
-	}
-
-	if defaultTrustedProfileTargetIntf, ok := d.GetOk(isInstanceDefaultTrustedProfileTarget); ok {
-		defaultTrustedProfiletarget := defaultTrustedProfileTargetIntf.(string)
-
-		target := &vpcv1.TrustedProfileIdentity{}
-		if strings.HasPrefix(defaultTrustedProfiletarget, "crn") {
-			target.CRN = &defaultTrustedProfiletarget
-		} else {
-			target.ID = &defaultTrustedProfiletarget
-		}
-		instanceproto.DefaultTrustedProfile = &vpcv1.InstanceDefaultTrustedProfilePrototype{
-			Target: target,
-		}
-
-		if defaultTrustedProfileAutoLinkIntf, ok := d.GetOkExists(isInstanceDefaultTrustedProfileAutoLink); ok {
-			defaultTrustedProfileAutoLink := defaultTrustedProfileAutoLinkIntf.(bool)
-			instanceproto.DefaultTrustedProfile.AutoLink = &defaultTrustedProfileAutoLink
-		}
-	}
-
-	if dHostIdInf, ok := d.GetOk(isPlacementTargetDedicatedHost); ok {
-		dHostIdStr := dHostIdInf.(string)
-		dHostPlaementTarget := &vpcv1.InstancePlacementTargetPrototypeDedicatedHostIdentity{
-			ID: &dHostIdStr,
-		}
-		instanceproto.PlacementTarget = dHostPlaementTarget
-	} else if dHostGrpIdInf, ok := d.GetOk(isPlacementTargetDedicatedHostGroup); ok {
-		dHostGrpIdStr := dHostGrpIdInf.(string)
-		dHostGrpPlaementTarget := &vpcv1.InstancePlacementTargetPrototypeDedicatedHostGroupIdentity{
-			ID: &dHostGrpIdStr,
-		}
-		instanceproto.PlacementTarget = dHostGrpPlaementTarget
-	} else if placementGroupInf, ok := d.GetOk(isPlacementTargetPlacementGroup); ok {
-		placementGrpStr := placementGroupInf.(string)
-		placementGrp := &vpcv1.InstancePlacementTargetPrototypePlacementGroupIdentity{
-			ID: &placementGrpStr,
-		}
-		instanceproto.PlacementTarget = placementGrp
-	}
-
-	if boot, ok := d.GetOk(isInstanceBootVolume); ok {
-		bootvol := boot.([]interface{})[0].(map[string]interface{})
-		var volTemplate = &vpcv1.VolumePrototypeInstanceBySourceSnapshotContext{}
-
-		name, ok := bootvol[isInstanceBootAttachmentName]
-		namestr := name.(string)
-		if namestr != "" && ok {
-			volTemplate.Name = &namestr
-		}
-		sizeOk, ok := bootvol[isInstanceBootSize]
-		size := sizeOk.(int)
-		if size != 0 && ok {
-			sizeInt64 := int64(size)
-			volTemplate.Capacity = &sizeInt64
-		}
-		enc, ok := bootvol[isInstanceBootEncryption]
-		encstr := enc.(string)
-		if ok && encstr != "" {
-			volTemplate.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
-				CRN: &encstr,
-			}
-		}
-		volprof := "general-purpose"
-		volTemplate.Profile = &vpcv1.VolumeProfileIdentity{
-			Name: &volprof,
-		}
-		var userTags *schema.Set
-		if v, ok := bootvol[isInstanceBootVolumeTags]; ok {
-			userTags = v.(*schema.Set)
-			if userTags != nil && userTags.Len() != 0 {
-				userTagsArray := make([]string, userTags.Len())
-				for i, userTag := range userTags.List() {
-					userTagStr := userTag.(string)
-					userTagsArray[i] = userTagStr
-				}
-				volTemplate.UserTags = userTagsArray
-			}
-		}
-		snapshotId, ok := bootvol[isInstanceVolumeSnapshot]
-		snapshotIdStr := snapshotId.(string)
-		if snapshotIdStr != "" && ok {
-			volTemplate.SourceSnapshot = &vpcv1.SnapshotIdentity{
-				ID: &snapshotIdStr,
-			}
-		}
-		deleteboolIntf := bootvol[isInstanceVolAttVolAutoDelete]
-		deletebool := deleteboolIntf.(bool)
-		instanceproto.BootVolumeAttachment = &vpcv1.VolumeAttachmentPrototypeInstanceBySourceSnapshotContext{
-			DeleteVolumeOnInstanceDelete: &deletebool,
-			Volume:                       volTemplate,
-		}
-	}
-	if totalVolBandwidthIntf, ok := d.GetOk(isInstanceTotalVolumeBandwidth); ok {
-		totalVolBandwidthStr := int64(totalVolBandwidthIntf.(int))
-		instanceproto.TotalVolumeBandwidth = &totalVolBandwidthStr
-	}
-
-	if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
-		primnic := primnicintf.([]interface{})[0].(map[string]interface{})
-		subnetintf, _ := primnic[isInstanceNicSubnet]
-		subnetintfstr := subnetintf.(string)
-		var primnicobj = &vpcv1.NetworkInterfacePrototype{}
-		primnicobj.Subnet = &vpcv1.SubnetIdentity{
-			ID: &subnetintfstr,
-		}
-		name, _ := primnic[isInstanceNicName]
-		namestr := name.(string)
-		if namestr != "" {
-			primnicobj.Name = &namestr
-		}
-
-		// reserved ip changes
-
-		var ipv4str, reservedIp, reservedipv4, reservedipname string
-		var autodelete, okAuto bool
-		ipv4, _ := primnic[isInstanceNicPrimaryIpv4Address]
-		ipv4str = ipv4.(string)
-
-		primaryIpOk, ok := primnic[isInstanceNicPrimaryIP]
-		if ok && len(primaryIpOk.([]interface{})) > 0 {
-			primip := primaryIpOk.([]interface{})[0].(map[string]interface{})
-
-			reservedipok, _ := primip[isInstanceNicReservedIpId]
-			reservedIp = reservedipok.(string)
-
-			reservedipv4Ok, _ := primip[isInstanceNicReservedIpAddress]
-			reservedipv4 = reservedipv4Ok.(string)
-
-			reservedipnameOk, _ := primip[isInstanceNicReservedIpName]
-			reservedipname = reservedipnameOk.(string)
-			var reservedipautodeleteok interface{}
-			reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
-			autodelete = reservedipautodeleteok.(bool)
-		}
-		if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-			return fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-		}
-		if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
-			return fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
-		}
-		if reservedIp != "" {
-			primnicobj.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
-				ID: &reservedIp,
-			}
-		} else {
-			if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
-				primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-				if ipv4str != "" {
-					primaryipobj.Address = &ipv4str
-				}
-				if reservedipv4 != "" {
-					primaryipobj.Address = &reservedipv4
-				}
-				if reservedipname != "" {
-					primaryipobj.Name = &reservedipname
-				}
-				if okAuto {
-					primaryipobj.AutoDelete = &autodelete
-				}
-				primnicobj.PrimaryIP = primaryipobj
-			}
-		}
-
-		allowIPSpoofing, ok := primnic[isInstanceNicAllowIPSpoofing]
-		allowIPSpoofingbool := allowIPSpoofing.(bool)
-		if ok {
-			primnicobj.AllowIPSpoofing = &allowIPSpoofingbool
-		}
-		secgrpintf, ok := primnic[isInstanceNicSecurityGroups]
-		if ok {
-			secgrpSet := secgrpintf.(*schema.Set)
-			if secgrpSet.Len() != 0 {
-				var secgrpobjs = make([]vpcv1.SecurityGroupIdentityIntf, secgrpSet.Len())
-				for i, secgrpIntf := range secgrpSet.List() {
-					secgrpIntfstr := secgrpIntf.(string)
-					secgrpobjs[i] = &vpcv1.SecurityGroupIdentity{
-						ID: &secgrpIntfstr,
-					}
-				}
-				primnicobj.SecurityGroups = secgrpobjs
-			}
-		}
-		instanceproto.PrimaryNetworkInterface = primnicobj
-	}
-
-	if nicsintf, ok := d.GetOk(isInstanceNetworkInterfaces); ok {
-		nics := nicsintf.([]interface{})
-		var intfs []vpcv1.NetworkInterfacePrototype
-		for _, resource := range nics {
-			nic := resource.(map[string]interface{})
-			nwInterface := &vpcv1.NetworkInterfacePrototype{}
-			subnetintf, _ := nic[isInstanceNicSubnet]
-			subnetintfstr := subnetintf.(string)
-			nwInterface.Subnet = &vpcv1.SubnetIdentity{
-				ID: &subnetintfstr,
-			}
-			name, ok := nic[isInstanceNicName]
-			namestr := name.(string)
-			if ok && namestr != "" {
-				nwInterface.Name = &namestr
-			}
-			// reserved ip changes
-
-			var ipv4str, reservedIp, reservedipv4, reservedipname string
-			var autodelete, okAuto bool
-			ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
-			ipv4str = ipv4.(string)
-
-			primaryIpOk, ok := nic[isInstanceNicPrimaryIP]
-			if ok && len(primaryIpOk.([]interface{})) > 0 {
-				primip := primaryIpOk.([]interface{})[0].(map[string]interface{})
-
-				reservedipok, _ := primip[isInstanceNicReservedIpId]
-				reservedIp = reservedipok.(string)
-
-				reservedipv4Ok, _ := primip[isInstanceNicReservedIpAddress]
-				reservedipv4 = reservedipv4Ok.(string)
-
-				reservedipnameOk, _ := primip[isInstanceNicReservedIpName]
-				reservedipname = reservedipnameOk.(string)
-				var reservedipautodeleteok interface{}
-				reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
-				autodelete = reservedipautodeleteok.(bool)
-			}
-			if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-				return fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-			}
-			if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
-				return fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
-			}
-			if reservedIp != "" {
-				nwInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
-					ID: &reservedIp,
-				}
-			} else {
-				if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
-					primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-					if ipv4str != "" {
-						primaryipobj.Address = &ipv4str
-					}
-					if reservedipv4 != "" {
-						primaryipobj.Address = &reservedipv4
-					}
-					if reservedipname != "" {
-						primaryipobj.Name = &reservedipname
-					}
-					if okAuto {
-						primaryipobj.AutoDelete = &autodelete
-					}
-					nwInterface.PrimaryIP = primaryipobj
-				}
-			}
-			allowIPSpoofing, ok := nic[isInstanceNicAllowIPSpoofing]
-			allowIPSpoofingbool := allowIPSpoofing.(bool)
-			if ok {
-				nwInterface.AllowIPSpoofing = &allowIPSpoofingbool
-			}
-			secgrpintf, ok := nic[isInstanceNicSecurityGroups]
-			if ok {
-				secgrpSet := secgrpintf.(*schema.Set)
-				if secgrpSet.Len() != 0 {
-					var secgrpobjs = make([]vpcv1.SecurityGroupIdentityIntf, secgrpSet.Len())
-					for i, secgrpIntf := range secgrpSet.List() {
-						secgrpIntfstr := secgrpIntf.(string)
-						secgrpobjs[i] = &vpcv1.SecurityGroupIdentity{
-							ID: &secgrpIntfstr,
-						}
-					}
-					nwInterface.SecurityGroups = secgrpobjs
-				}
-			}
-			intfs = append(intfs, *nwInterface)
-		}
-		instanceproto.NetworkInterfaces = intfs
-	}
-
-	keySet := d.Get(isInstanceKeys).(*schema.Set)
-	if keySet.Len() != 0 {
-		keyobjs := make([]vpcv1.KeyIdentityIntf, keySet.Len())
-		for i, key := range keySet.List() {
-			keystr := key.(string)
-			keyobjs[i] = &vpcv1.KeyIdentity{
-				ID: &keystr,
-			}
-		}
-		instanceproto.Keys = keyobjs
-	}
-
-	if userdata, ok := d.GetOk(isInstanceUserData); ok {
-		userdatastr := userdata.(string)
-		instanceproto.UserData = &userdatastr
-	}
-
-	if grp, ok := d.GetOk(isInstanceResourceGroup); ok {
-		grpstr := grp.(string)
-		instanceproto.ResourceGroup = &vpcv1.ResourceGroupIdentity{
-			ID: &grpstr,
-		}
-
-	}
-	if availablePolicyItem, ok := d.GetOk(isInstanceAvailablePolicyHostFailure); ok {
-		hostFailure := availablePolicyItem.(string)
-		instanceproto.AvailabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPrototype{
-			HostFailure: &hostFailure,
-		}
-	}
-	metadataServiceEnabled := d.Get(isInstanceMetadataServiceEnabled).(bool)
-	if metadataServiceEnabled {
-		instanceproto.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
-			Enabled: &metadataServiceEnabled,
-		}
-	}
-
-	if metadataService := GetInstanceMetadataServiceOptions(d); metadataService != nil {
-		instanceproto.MetadataService = metadataService
-	}
-
-	options := &vpcv1.CreateInstanceOptions{
-		InstancePrototype: instanceproto,
-	}
-
-	instance, response, err := sess.CreateInstance(options)
-	if err != nil {
-		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
-	}
-	d.SetId(*instance.ID)
-
-	log.Printf("[INFO] Instance : %s", *instance.ID)
-	d.Set(isInstanceStatus, instance.Status)
-
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
-	if err != nil {
-		return err
-	}
-
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
-		if err != nil {
-			log.Printf(
-				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
-		}
-	}
-	if _, ok := d.GetOk(isInstanceAccessTags); ok {
-		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
-		if err != nil {
-			log.Printf(
-				"Error on create of resource instance (%s) access tags: %s", d.Id(), err)
-		}
+	if autoDeleteIntf, ok := d.GetOk("boot_volume.0.auto_delete_volume"); ok {
+		autoDelete := autoDeleteIntf.(bool)
+		bootVolAttachment.DeleteVolumeOnInstanceDelete = &autoDelete
 	}
-	return nil
+	return bootVolAttachment
 }
 
-func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone string) error {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return err
-	}
-	instanceproto := &vpcv1.InstancePrototypeInstanceByVolume{
-		Zone: &vpcv1.ZoneIdentity{
-			Name: &zone,
-		},
-		Profile: &vpcv1.InstanceProfileIdentity{
-			Name: &profile,
-		},
-		Name: &name,
-		VPC: &vpcv1.VPCIdentity{
-			ID: &vpcID,
-		},
-	}
-
-	if defaultTrustedProfileTargetIntf, ok := d.GetOk(isInstanceDefaultTrustedProfileTarget); ok {
-		defaultTrustedProfiletarget := defaultTrustedProfileTargetIntf.(string)
-
-		target := &vpcv1.TrustedProfileIdentity{}
-		if strings.HasPrefix(defaultTrustedProfiletarget, "crn") {
-			target.CRN = &defaultTrustedProfiletarget
-		} else {
-			target.ID = &defaultTrustedProfiletarget
-		}
-		instanceproto.DefaultTrustedProfile = &vpcv1.InstanceDefaultTrustedProfilePrototype{
-			Target: target,
-		}
-
-		if defaultTrustedProfileAutoLinkIntf, ok := d.GetOkExists(isInstanceDefaultTrustedProfileAutoLink); ok {
-			defaultTrustedProfileAutoLink := defaultTrustedProfileAutoLinkIntf.(bool)
-			instanceproto.DefaultTrustedProfile.AutoLink = &defaultTrustedProfileAutoLink
-		}
-	}
-
-	if dHostIdInf, ok := d.GetOk(isPlacementTargetDedicatedHost); ok {
-		dHostIdStr := dHostIdInf.(string)
-		dHostPlaementTarget := &vpcv1.InstancePlacementTargetPrototypeDedicatedHostIdentity{
-			ID: &dHostIdStr,
-		}
-		instanceproto.PlacementTarget = dHostPlaementTarget
-	} else if dHostGrpIdInf, ok := d.GetOk(isPlacementTargetDedicatedHostGroup); ok {
-		dHostGrpIdStr := dHostGrpIdInf.(string)
-		dHostGrpPlaementTarget := &vpcv1.InstancePlacementTargetPrototypeDedicatedHostGroupIdentity{
-			ID: &dHostGrpIdStr,
-		}
-		instanceproto.PlacementTarget = dHostGrpPlaementTarget
-	} else if placementGroupInf, ok := d.GetOk(isPlacementTargetPlacementGroup); ok {
-		placementGrpStr := placementGroupInf.(string)
-		placementGrp := &vpcv1.InstancePlacementTargetPrototypePlacementGroupIdentity{
-			ID: &placementGrpStr,
-		}
-		instanceproto.PlacementTarget = placementGrp
-	}
-
-	if boot, ok := d.GetOk(isInstanceBootVolume); ok {
-		bootvol := boot.([]interface{})[0].(map[string]interface{})
-		volumeId, ok := bootvol[isInstanceBootVolumeId]
-
-		volumeIdStr := volumeId.(string)
-		bootVolAttachment := &vpcv1.VolumeAttachmentPrototypeInstanceByVolumeContext{}
-		if ok && volumeIdStr != "" {
-			volumeIdentity := &vpcv1.VolumeIdentity{
-				ID: &volumeIdStr,
-			}
-			bootVolAttachment.Volume = volumeIdentity
-		}
-		if autoDeleteIntf, ok := d.GetOk("boot_volume.0.auto_delete_volume"); ok {
-			autoDelete := autoDeleteIntf.(bool)
-			bootVolAttachment.DeleteVolumeOnInstanceDelete = &autoDelete
-		}
-		instanceproto.BootVolumeAttachment = bootVolAttachment
-	}
-	if totalVolBandwidthIntf, ok := d.GetOk(isInstanceTotalVolumeBandwidth); ok {
-		totalVolBandwidthStr := int64(totalVolBandwidthIntf.(int))
-		instanceproto.TotalVolumeBandwidth = &totalVolBandwidthStr
-	}
-
-	if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
-		primnic := primnicintf.([]interface{})[0].(map[string]interface{})
-		subnetintf, _ := primnic[isInstanceNicSubnet]
-		subnetintfstr := subnetintf.(string)
-		var primnicobj = &vpcv1.NetworkInterfacePrototype{}
-		primnicobj.Subnet = &vpcv1.SubnetIdentity{
-			ID: &subnetintfstr,
-		}
-		name, _ := primnic[isInstanceNicName]
-		namestr := name.(string)
-		if namestr != "" {
-			primnicobj.Name = &namestr
-		}
-
-		// reserved ip changes
-
-		var ipv4str, reservedIp, reservedipv4, reservedipname string
-		var autodelete, okAuto bool
-		ipv4, _ := primnic[isInstanceNicPrimaryIpv4Address]
-		ipv4str = ipv4.(string)
-
-		primaryIpOk, ok := primnic[isInstanceNicPrimaryIP]
-		if ok && len(primaryIpOk.([]interface{})) > 0 {
-			primip := primaryIpOk.([]interface{})[0].(map[string]interface{})
-
-			reservedipok, _ := primip[isInstanceNicReservedIpId]
-			reservedIp = reservedipok.(string)
-
-			reservedipv4Ok, _ := primip[isInstanceNicReservedIpAddress]
-			reservedipv4 = reservedipv4Ok.(string)
-
-			reservedipnameOk, _ := primip[isInstanceNicReservedIpName]
-			reservedipname = reservedipnameOk.(string)
-			var reservedipautodeleteok interface{}
-			reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
-			autodelete = reservedipautodeleteok.(bool)
-		}
-		if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-			return fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-		}
-		if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
-			return fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
-		}
-		if reservedIp != "" {
-			primnicobj.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
-				ID: &reservedIp,
-			}
-		} else {
-			if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
-				primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-				if ipv4str != "" {
-					primaryipobj.Address = &ipv4str
-				}
-				if reservedipv4 != "" {
-					primaryipobj.Address = &reservedipv4
-				}
-				if reservedipname != "" {
-					primaryipobj.Name = &reservedipname
-				}
-				if okAuto {
-					primaryipobj.AutoDelete = &autodelete
-				}
-				primnicobj.PrimaryIP = primaryipobj
-			}
-		}
-
-		allowIPSpoofing, ok := primnic[isInstanceNicAllowIPSpoofing]
-		allowIPSpoofingbool := allowIPSpoofing.(bool)
-		if ok {
-			primnicobj.AllowIPSpoofing = &allowIPSpoofingbool
-		}
-		secgrpintf, ok := primnic[isInstanceNicSecurityGroups]
-		if ok {
-			secgrpSet := secgrpintf.(*schema.Set)
-			if secgrpSet.Len() != 0 {
-				var secgrpobjs = make([]vpcv1.SecurityGroupIdentityIntf, secgrpSet.Len())
-				for i, secgrpIntf := range secgrpSet.List() {
-					secgrpIntfstr := secgrpIntf.(string)
-					secgrpobjs[i] = &vpcv1.SecurityGroupIdentity{
-						ID: &secgrpIntfstr,
-					}
-				}
-				primnicobj.SecurityGroups = secgrpobjs
-			}
-		}
-		instanceproto.PrimaryNetworkInterface = primnicobj
-	}
-
-	if nicsintf, ok := d.GetOk(isInstanceNetworkInterfaces); ok {
-		nics := nicsintf.([]interface{})
-		var intfs []vpcv1.NetworkInterfacePrototype
-		for _, resource := range nics {
-			nic := resource.(map[string]interface{})
-			nwInterface := &vpcv1.NetworkInterfacePrototype{}
-			subnetintf, _ := nic[isInstanceNicSubnet]
-			subnetintfstr := subnetintf.(string)
-			nwInterface.Subnet = &vpcv1.SubnetIdentity{
-				ID: &subnetintfstr,
-			}
-			name, ok := nic[isInstanceNicName]
-			namestr := name.(string)
-			if ok && namestr != "" {
-				nwInterface.Name = &namestr
-			}
-			// reserved ip changes
-
-			var ipv4str, reservedIp, reservedipv4, reservedipname string
-			var autodelete, okAuto bool
-			ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
-			ipv4str = ipv4.(string)
-
-			primaryIpOk, ok := nic[isInstanceNicPrimaryIP]
-			if ok && len(primaryIpOk.([]interface{})) > 0 {
-				primip := primaryIpOk.([]interface{})[0].(map[string]interface{})
-
-				reservedipok, _ := primip[isInstanceNicReservedIpId]
-				reservedIp = reservedipok.(string)
-
-				reservedipv4Ok, _ := primip[isInstanceNicReservedIpAddress]
-				reservedipv4 = reservedipv4Ok.(string)
-
-				reservedipnameOk, _ := primip[isInstanceNicReservedIpName]
-				reservedipname = reservedipnameOk.(string)
-				var reservedipautodeleteok interface{}
-				reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
-				autodelete = reservedipautodeleteok.(bool)
-			}
-			if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-				return fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-			}
-			if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
-				return fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
-			}
-			if reservedIp != "" {
-				nwInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
-					ID: &reservedIp,
-				}
-			} else {
-				if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
-					primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-					if ipv4str != "" {
-						primaryipobj.Address = &ipv4str
-					}
-					if reservedipv4 != "" {
-						primaryipobj.Address = &reservedipv4
-					}
-					if reservedipname != "" {
-						primaryipobj.Name = &reservedipname
-					}
-					if okAuto {
-						primaryipobj.AutoDelete = &autodelete
-					}
-					nwInterface.PrimaryIP = primaryipobj
-				}
-			}
-			allowIPSpoofing, ok := nic[isInstanceNicAllowIPSpoofing]
-			allowIPSpoofingbool := allowIPSpoofing.(bool)
-			if ok {
-				nwInterface.AllowIPSpoofing = &allowIPSpoofingbool
-			}
-			secgrpintf, ok := nic[isInstanceNicSecurityGroups]
-			if ok {
-				secgrpSet := secgrpintf.(*schema.Set)
-				if secgrpSet.Len() != 0 {
-					var secgrpobjs = make([]vpcv1.SecurityGroupIdentityIntf, secgrpSet.Len())
-					for i, secgrpIntf := range secgrpSet.List() {
-						secgrpIntfstr := secgrpIntf.(string)
-						secgrpobjs[i] = &vpcv1.SecurityGroupIdentity{
-							ID: &secgrpIntfstr,
-						}
-					}
-					nwInterface.SecurityGroups = secgrpobjs
-				}
-			}
-			intfs = append(intfs, *nwInterface)
-		}
-		instanceproto.NetworkInterfaces = intfs
-	}
-
-	keySet := d.Get(isInstanceKeys).(*schema.Set)
-	if keySet.Len() != 0 {
-		keyobjs := make([]vpcv1.KeyIdentityIntf, keySet.Len())
-		for i, key := range keySet.List() {
-			keystr := key.(string)
-			keyobjs[i] = &vpcv1.KeyIdentity{
-				ID: &keystr,
-			}
-		}
-		instanceproto.Keys = keyobjs
-	}
-
-	if userdata, ok := d.GetOk(isInstanceUserData); ok {
-		userdatastr := userdata.(string)
-		instanceproto.UserData = &userdatastr
-	}
-
-	if grp, ok := d.GetOk(isInstanceResourceGroup); ok {
-		grpstr := grp.(string)
-		instanceproto.ResourceGroup = &vpcv1.ResourceGroupIdentity{
-			ID: &grpstr,
-		}
-
-	}
-	if availablePolicyItem, ok := d.GetOk(isInstanceAvailablePolicyHostFailure); ok {
-		hostFailure := availablePolicyItem.(string)
-		instanceproto.AvailabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPrototype{
-			HostFailure: &hostFailure,
-		}
-	}
-	metadataServiceEnabled := d.Get(isInstanceMetadataServiceEnabled).(bool)
-	if metadataServiceEnabled {
-		instanceproto.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
-			Enabled: &metadataServiceEnabled,
-		}
-	}
-	if metadataService := GetInstanceMetadataServiceOptions(d); metadataService != nil {
-		instanceproto.MetadataService = metadataService
-	}
-
-	options := &vpcv1.CreateInstanceOptions{
-		InstancePrototype: instanceproto,
-	}
-
-	instance, response, err := sess.CreateInstance(options)
-	if err != nil {
-		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
-	}
-	d.SetId(*instance.ID)
-
-	log.Printf("[INFO] Instance : %s", *instance.ID)
-	d.Set(isInstanceStatus, instance.Status)
-
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
-	if err != nil {
-		return err
+func instancePrototypeBySourceSnapshot(instanceproto *vpcv1.InstancePrototype, bootVolumeAttachment *vpcv1.VolumeAttachmentPrototypeInstanceBySourceSnapshotContext) *vpcv1.InstancePrototypeInstanceBySourceSnapshot {
+	return &vpcv1.InstancePrototypeInstanceBySourceSnapshot{
+		AvailabilityPolicy:      instanceproto.AvailabilityPolicy,
+		DefaultTrustedProfile:   instanceproto.DefaultTrustedProfile,
+		Keys:                    instanceproto.Keys,
+		MetadataService:         instanceproto.MetadataService,
+		Name:                    instanceproto.Name,
+		PlacementTarget:         instanceproto.PlacementTarget,
+		Profile:                 instanceproto.Profile,
+		ResourceGroup:           instanceproto.ResourceGroup,
+		TotalVolumeBandwidth:    instanceproto.TotalVolumeBandwidth,
+		UserData:                instanceproto.UserData,
+		VolumeAttachments:       instanceproto.VolumeAttachments,
+		VPC:                     instanceproto.VPC,
+		BootVolumeAttachment:    bootVolumeAttachment,
+		NetworkInterfaces:       instanceproto.NetworkInterfaces,
+		PrimaryNetworkInterface: instanceproto.PrimaryNetworkInterface,
+		Zone:                    instanceproto.Zone,
 	}
+}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
-		if err != nil {
-			log.Printf(
-				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
-		}
-	}
-	if _, ok := d.GetOk(isInstanceAccessTags); ok {
-		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
-		if err != nil {
-			log.Printf(
-				"Error on create of resource instance (%s) access tags: %s", d.Id(), err)
-		}
+func instancePrototypeByVolume(instanceproto *vpcv1.InstancePrototype, bootVolumeAttachment *vpcv1.VolumeAttachmentPrototypeInstanceByVolumeContext) *vpcv1.InstancePrototypeInstanceByVolume {
+	return &vpcv1.InstancePrototypeInstanceByVolume{
+		AvailabilityPolicy:      instanceproto.AvailabilityPolicy,
+		DefaultTrustedProfile:   instanceproto.DefaultTrustedProfile,
+		Keys:                    instanceproto.Keys,
+		MetadataService:         instanceproto.MetadataService,
+		Name:                    instanceproto.Name,
+		PlacementTarget:         instanceproto.PlacementTarget,
+		Profile:                 instanceproto.Profile,
+		ResourceGroup:           instanceproto.ResourceGroup,
+		TotalVolumeBandwidth:    instanceproto.TotalVolumeBandwidth,
+		UserData:                instanceproto.UserData,
+		VolumeAttachments:       instanceproto.VolumeAttachments,
+		VPC:                     instanceproto.VPC,
+		BootVolumeAttachment:    bootVolumeAttachment,
+		NetworkInterfaces:       instanceproto.NetworkInterfaces,
+		PrimaryNetworkInterface: instanceproto.PrimaryNetworkInterface,
+		Zone:                    instanceproto.Zone,
 	}
-	return nil
 }
 
 func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error {
@@ -2927,39 +1551,9 @@ func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	name := d.Get(isInstanceName).(string)
 	vpcID := d.Get(isInstanceVPC).(string)
 	zone := d.Get(isInstanceZone).(string)
-	image := d.Get(isInstanceImage).(string)
-	snapshot := d.Get("boot_volume.0.snapshot").(string)
-	volume := d.Get("boot_volume.0.volume_id").(string)
-	template := d.Get(isInstanceSourceTemplate).(string)
-	if catalogOfferingOk, ok := d.GetOk(isInstanceCatalogOffering); ok {
-		catalogOffering := catalogOfferingOk.([]interface{})[0].(map[string]interface{})
-		offeringCrn, _ := catalogOffering[isInstanceCatalogOfferingOfferingCrn].(string)
-		versionCrn, _ := catalogOffering[isInstanceCatalogOfferingVersionCrn].(string)
-		err := instanceCreateByCatalogOffering(d, meta, profile, name, vpcID, zone, image, offeringCrn, versionCrn)
-		if err != nil {
-			return err
-		}
-
-	} else if volume != "" {
-		err := instanceCreateByVolume(d, meta, profile, name, vpcID, zone)
-		if err != nil {
-			return err
-		}
-	} else if snapshot != "" {
-		err := instanceCreateBySnapshot(d, meta, profile, name, vpcID, zone)
-		if err != nil {
-			return err
-		}
-	} else if template != "" {
-		err := instanceCreateByTemplate(d, meta, profile, name, vpcID, zone, image, template)
-		if err != nil {
-			return err
-		}
-	} else {
-		err := instanceCreateByImage(d, meta, profile, name, vpcID, zone, image)
-		if err != nil {
-			return err
-		}
+	err := instanceCreate(d, meta, profile, name, vpcID, zone)
+	if err != nil {
+		return err
 	}
 
 	return resourceIBMisInstanceUpdate(d, meta)
@@ -4188,7 +2782,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
 	}
-	if d.HasChange(isInstanceTags) {
+	if d.HasChange(isInstanceTags) || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
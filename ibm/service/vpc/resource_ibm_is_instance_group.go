@@ -43,7 +43,7 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -136,6 +136,13 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 				Description: "number of instances in the intances group",
 			},
 
+			"instance_ids": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "IDs of the instances in the instance group, as load balancer pool member targets for example",
+			},
+
 			"vpc": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -148,6 +155,7 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 				Description: "Instance group status - deleting, healthy, scaling, unhealthy",
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			"tags": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -283,7 +291,7 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if _, ok := d.GetOk("tags"); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN, "", isInstanceGroupUserTagType)
 		if err != nil {
@@ -315,7 +323,7 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 	instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{}
 	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange(flex.ResourceTagsAll) {
 		instanceGroupID := d.Id()
 		getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
 		instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
@@ -435,6 +443,13 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 	}
 	d.Set("managers", managers)
 
+	// The memberships are informational, the instance group is read without them
+	if instanceIDs, err := instanceGroupInstanceIDs(sess, instanceGroupID); err != nil {
+		log.Printf("[WARN] Error on get of instance group (%s) instance IDs: %s", d.Id(), err)
+	} else {
+		d.Set("instance_ids", instanceIDs)
+	}
+
 	d.Set("status", *instanceGroup.Status)
 	d.Set("vpc", *instanceGroup.VPC.ID)
 	d.Set("crn", *instanceGroup.CRN)
@@ -613,3 +628,31 @@ func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (inter
 	return healthStateConf.WaitForState()
 
 }
+
+func instanceGroupInstanceIDs(sess *vpcv1.VpcV1, instanceGroupID string) ([]string, error) {
+	start := ""
+	instanceIDs := []string{}
+	for {
+		listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
+			InstanceGroupID: &instanceGroupID,
+		}
+		if start != "" {
+			listInstanceGroupMembershipsOptions.Start = &start
+		}
+		instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
+		}
+		for _, membership := range instanceGroupMembershipCollection.Memberships {
+			if membership.Instance != nil && membership.Instance.ID != nil {
+				instanceIDs = append(instanceIDs, *membership.Instance.ID)
+			}
+		}
+
+		start = flex.GetNext(instanceGroupMembershipCollection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return instanceIDs, nil
+}
@@ -133,8 +133,11 @@ func resourceIBMISInstanceGroupManagerPolicyCreate(d *schema.ResourceData, meta
 	}
 
 	isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-	conns.IbmMutexKV.Lock(isInsGrpKey)
-	defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutCreate, isInsGrpKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
@@ -149,6 +152,8 @@ func resourceIBMISInstanceGroupManagerPolicyCreate(d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceGroupID, instanceGroupManagerID, *instanceGroupManagerPolicy.ID))
 
+	// Read takes the lock for reading
+	unlock()
 	return resourceIBMISInstanceGroupManagerPolicyRead(d, meta)
 
 }
@@ -200,8 +205,11 @@ func resourceIBMISInstanceGroupManagerPolicyUpdate(d *schema.ResourceData, meta
 		updateInstanceGroupManagerPolicyOptions.InstanceGroupManagerPolicyPatch = instanceGroupManagerPolicyAsPatch
 
 		isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-		conns.IbmMutexKV.Lock(isInsGrpKey)
-		defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+		unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutUpdate, isInsGrpKey)
+		if err != nil {
+			return err
+		}
+		defer unlock()
 
 		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
@@ -212,6 +220,8 @@ func resourceIBMISInstanceGroupManagerPolicyUpdate(d *schema.ResourceData, meta
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error Updating InstanceGroup Manager Policy: %s\n%s", err, response)
 		}
+		// Read takes the lock for reading
+		unlock()
 	}
 	return resourceIBMISInstanceGroupManagerPolicyRead(d, meta)
 }
@@ -229,6 +239,11 @@ func resourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta in
 	instanceGroupID := parts[0]
 	instanceGroupManagerID := parts[1]
 	instanceGroupManagerPolicyID := parts[2]
+	unlock, err := conns.IbmLockManager.RLockOperation(d, meta, schema.TimeoutRead, "Instance_Group_Key_"+instanceGroupID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	getInstanceGroupManagerPolicyOptions := vpcv1.GetInstanceGroupManagerPolicyOptions{
 		ID:                     &instanceGroupManagerPolicyID,
@@ -275,8 +290,11 @@ func resourceIBMISInstanceGroupManagerPolicyDelete(d *schema.ResourceData, meta
 	}
 
 	isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-	conns.IbmMutexKV.Lock(isInsGrpKey)
-	defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutDelete, isInsGrpKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
 	if healthError != nil {
@@ -338,8 +338,11 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 	}
 
 	isNICKey := "instance_key_" + instance_id
-	conns.IbmMutexKV.Lock(isNICKey)
-	defer conns.IbmMutexKV.Unlock(isNICKey)
+	unlock, err := conns.IbmLockManager.Lock(context, meta, isNICKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	networkInterface, response, err := vpcClient.CreateInstanceNetworkInterfaceWithContext(context, createInstanceNetworkInterfaceOptions)
 	if err != nil {
@@ -381,6 +384,8 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	// Read takes the lock for reading
+	unlock()
 	return resourceIBMIsInstanceNetworkInterfaceRead(context, d, meta)
 }
 
@@ -399,6 +404,11 @@ func resourceIBMIsInstanceNetworkInterfaceRead(context context.Context, d *schem
 
 	getInstanceNetworkInterfaceOptions.SetInstanceID(parts[0])
 	getInstanceNetworkInterfaceOptions.SetID(parts[1])
+	unlock, err := conns.IbmLockManager.RLock(context, meta, "instance_key_"+parts[0])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	networkInterface, response, err := vpcClient.GetInstanceNetworkInterfaceWithContext(context, getInstanceNetworkInterfaceOptions)
 	if err != nil {
@@ -622,14 +632,19 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 	}
 	if hasChange {
 		isNICKey := "instance_key_" + instance_id
-		conns.IbmMutexKV.Lock(isNICKey)
-		defer conns.IbmMutexKV.Unlock(isNICKey)
+		unlock, err := conns.IbmLockManager.Lock(context, meta, isNICKey)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer unlock()
 		updateInstanceNetworkInterfaceOptions.NetworkInterfacePatch, _ = patchVals.AsPatch()
 		_, response, err := vpcClient.UpdateInstanceNetworkInterfaceWithContext(context, updateInstanceNetworkInterfaceOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("UpdateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
 		}
+		// Read takes the lock for reading
+		unlock()
 	}
 
 	if d.HasChange(isInstanceNicFloatingIP) {
@@ -711,8 +726,11 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 	instance_id := parts[0]
 	network_intf_id := parts[1]
 	isNICKey := "instance_key_" + instance_id
-	conns.IbmMutexKV.Lock(isNICKey)
-	defer conns.IbmMutexKV.Unlock(isNICKey)
+	unlock, err := conns.IbmLockManager.Lock(context, meta, isNICKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	deleteInstanceNetworkInterfaceOptions.SetInstanceID(instance_id)
 	deleteInstanceNetworkInterfaceOptions.SetID(network_intf_id)
@@ -57,8 +57,9 @@ func ResourceIBMISInstanceVolumeAttachment() *schema.Resource {
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
+			validate.InvokeConstraintValidator("ibm_is_instance_volume_attachment"),
 		),
 		Schema: map[string]*schema.Schema{
 			isInstanceId: {
@@ -266,7 +267,7 @@ func ResourceIBMISInstanceVolumeAttachmentValidator() *validate.ResourceValidato
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
-	ibmISInstanceVolumeAttachmentValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_volume_attachment", Schema: validateSchema}
+	ibmISInstanceVolumeAttachmentValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_volume_attachment", Schema: validateSchema, Constraints: volumeProfileConstraints()}
 	return &ibmISInstanceVolumeAttachmentValidator
 }
 
@@ -311,6 +312,7 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 				volProtoVol.UserTags = userTagsArray
 			}
 		}
+		volProtoVol.UserTags = flex.WithDefaultTags(volProtoVol.UserTags, meta)
 		volSnapshotStr := ""
 		if volSnapshot, ok := d.GetOk(isInstanceVolumeSnapshot); ok {
 			volSnapshotStr = volSnapshot.(string)
@@ -379,8 +381,11 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 	}
 
 	isInstanceKey := "instance_key_" + instanceId
-	conns.IbmMutexKV.Lock(isInstanceKey)
-	defer conns.IbmMutexKV.Unlock(isInstanceKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutCreate, isInstanceKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	instanceVolAtt, response, err := sess.CreateInstanceVolumeAttachment(instanceVolAttproto)
 	if err != nil {
@@ -605,7 +610,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 						envTags = strings.Split(schematicTags, ",")
 						userTagsArray = append(userTagsArray, envTags...)
 					}
-					volumeProfilePatchModel.UserTags = userTagsArray
+					volumeProfilePatchModel.UserTags = flex.WithDefaultTags(userTagsArray, meta)
 				}
 			}
 
@@ -712,8 +717,11 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 	}
 
 	isInstanceKey := "instance_key_" + instanceId
-	conns.IbmMutexKV.Lock(isInstanceKey)
-	defer conns.IbmMutexKV.Unlock(isInstanceKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutDelete, isInstanceKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	_, err = instanceC.DeleteInstanceVolumeAttachment(deleteInstanceVolAttOptions)
 	if err != nil {
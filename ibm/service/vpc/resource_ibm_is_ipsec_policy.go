@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"context"
 	"fmt"
 	"log"
 
@@ -40,10 +39,7 @@ func ResourceIBMISIPSecPolicy() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.All(
-			customdiff.Sequence(
-				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceIPSecPolicyValidate(diff)
-				}),
+			validate.InvokeConstraintValidator("ibm_is_ipsec_policy"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -187,7 +183,17 @@ func ResourceIBMISIPSECValidator() *validate.ResourceValidator {
 			Required:                   true,
 			AllowedValues:              pfs})
 
-	ibmISIPSECResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_ipsec_policy", Schema: validateSchema}
+	constraints := []validate.ValidateConstraint{
+		{
+			ConstraintIdentifier: validate.AllowedValuesIf,
+			Identifiers:          []string{isIpSecAuthenticationAlg},
+			Condition:            isIpSecEncryptionAlg,
+			ConditionValues:      "aes128gcm16, aes192gcm16, aes256gcm16",
+			AllowedValues:        "disabled",
+		},
+	}
+
+	ibmISIPSECResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_ipsec_policy", Schema: validateSchema, Constraints: constraints}
 	return &ibmISIPSECResourceValidator
 }
 
@@ -64,7 +64,7 @@ func ResourceIBMISLB() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -214,6 +214,7 @@ func ResourceIBMISLB() *schema.Resource {
 				ConflictsWith: []string{isLBLogging},
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isLBTags: {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -469,7 +470,7 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isLBTags); ok || v != "" {
+	if _, ok := d.GetOk(isLBTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isLBTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isUserTagType)
 		if err != nil {
@@ -693,7 +694,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
 		}
-		if d.HasChange(isLBTags) {
+		if d.HasChange(isLBTags) || d.HasChange(flex.ResourceTagsAll) {
 			oldList, newList := d.GetChange(isLBTags)
 			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isUserTagType)
 			if err != nil {
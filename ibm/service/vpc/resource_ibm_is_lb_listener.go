@@ -255,14 +255,19 @@ func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutCreate, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
-	err := lbListenerCreate(d, meta, lbID, protocol, defPool, certificateCRN, listener, uri, port, portMin, portMax, connLimit, httpStatusCode)
+	err = lbListenerCreate(d, meta, lbID, protocol, defPool, certificateCRN, listener, uri, port, portMin, portMax, connLimit, httpStatusCode)
 	if err != nil {
 		return err
 	}
 
+	// Read takes the lock for reading
+	unlock()
 	return resourceIBMISLBListenerRead(d, meta)
 }
 
@@ -420,6 +425,11 @@ func resourceIBMISLBListenerRead(d *schema.ResourceData, meta interface{}) error
 
 	lbID := parts[0]
 	lbListenerID := parts[1]
+	unlock, err := conns.IbmLockManager.RLockOperation(d, meta, schema.TimeoutRead, "load_balancer_key_"+lbID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	err = lbListenerGet(d, meta, lbID, lbListenerID)
 	if err != nil {
@@ -621,8 +631,11 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		updateLoadBalancerListenerOptions.LoadBalancerListenerPatch = loadBalancerListenerPatch
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutUpdate, isLBKey)
+		if err != nil {
+			return err
+		}
+		defer unlock()
 
 		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -660,8 +673,11 @@ func resourceIBMISLBListenerDelete(d *schema.ResourceData, meta interface{}) err
 	lbListenerID := parts[1]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutDelete, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	err = lbListenerDelete(d, meta, lbID, lbListenerID)
 	if err != nil {
@@ -472,8 +472,11 @@ func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, list
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutCreate, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -586,6 +589,11 @@ func resourceIBMISLBListenerPolicyRead(d *schema.ResourceData, meta interface{})
 	lbID := parts[0]
 	listenerID := parts[1]
 	policyID := parts[2]
+	unlock, err := conns.IbmLockManager.RLockOperation(d, meta, schema.TimeoutRead, "load_balancer_key_"+lbID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	err = lbListenerPolicyGet(d, meta, lbID, listenerID, policyID)
 	if err != nil {
@@ -775,8 +783,11 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 		}
 		updatePolicyOptions.LoadBalancerListenerPolicyPatch = loadBalancerListenerPolicyPatch
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutUpdate, isLBKey)
+		if err != nil {
+			return err
+		}
+		defer unlock()
 
 		_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -809,8 +820,11 @@ func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{
 	policyID := parts[2]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutDelete, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	err = lbListenerPolicyDelete(d, meta, lbID, listenerID, policyID)
 	if err != nil {
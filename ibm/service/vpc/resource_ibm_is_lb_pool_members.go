@@ -0,0 +1,338 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isLBPoolMembers             = "members"
+	isLBPoolMemberID            = "id"
+	isLBPoolMemberWeightDefault = 50
+)
+
+func ResourceIBMISLBPoolMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISLBPoolMembersCreate,
+		ReadContext:   resourceIBMISLBPoolMembersRead,
+		UpdateContext: resourceIBMISLBPoolMembersUpdate,
+		DeleteContext: resourceIBMISLBPoolMembersDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isLBID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Load balancer ID",
+			},
+
+			isLBPoolID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
+					// The pool attribute of ibm_is_lb_pool is <load balancer ID>/<pool ID>
+					poolID, err := getPoolId(n)
+					return o != "" && err == nil && poolID == o
+				},
+				Description: "Load balancer pool ID",
+			},
+
+			isLBPoolMembers: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         resourceIBMISLBPoolMembersHash,
+				Description: "The complete set of members of the load balancer pool, the members not listed are removed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isLBPoolMemberID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Load balancer pool member id",
+						},
+
+						isLBPoolMemberPort: {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Load Balancer Pool port",
+						},
+
+						isLBPoolMemberTargetAddress: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Load balancer pool member target address, exclusive of target_id",
+						},
+
+						isLBPoolMemberTargetID: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Load balancer pool member target id, like the ID of an instance, exclusive of target_address",
+						},
+
+						isLBPoolMemberWeight: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      isLBPoolMemberWeightDefault,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool_member", isLBPoolMemberWeight),
+							Description:  "Load balancer pool member weight",
+						},
+
+						isLBPoolMemberProvisioningStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Load balancer Pool member provisioning status",
+						},
+
+						isLBPoolMemberHealth: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "LB Pool member health",
+						},
+
+						isLBPoolMemberHref: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "LB pool member Href value",
+						},
+					},
+				},
+			},
+
+			flex.RelatedCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The crn of the LB resource",
+			},
+		},
+	}
+}
+
+func resourceIBMISLBPoolMembersCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbID := d.Get(isLBID).(string)
+	lbPoolID, err := getPoolId(d.Get(isLBPoolID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := replaceIBMISLBPoolMembers(context, d, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return flex.DiagFromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", lbID, lbPoolID))
+	return resourceIBMISLBPoolMembersRead(context, d, meta)
+}
+
+func resourceIBMISLBPoolMembersRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	lbID, lbPoolID, err := parseIBMISLBPoolMembersID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	unlock, err := conns.IbmLockManager.RLock(context, meta, "load_balancer_key_"+lbID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	listLoadBalancerPoolMembersOptions := &vpcv1.ListLoadBalancerPoolMembersOptions{
+		LoadBalancerID: &lbID,
+		PoolID:         &lbPoolID,
+	}
+	collection, response, err := sess.ListLoadBalancerPoolMembersWithContext(context, listLoadBalancerPoolMembersOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return flex.APIErrorDiag(err, response, "vpc", "ListLoadBalancerPoolMembers", "ibm_is_lb_pool_members", d.Id())
+	}
+	members := make([]interface{}, 0, len(collection.Members))
+	for _, member := range collection.Members {
+		members = append(members, flattenIBMISLBPoolMember(member))
+	}
+	d.Set(isLBID, lbID)
+	d.Set(isLBPoolID, lbPoolID)
+	if err = d.Set(isLBPoolMembers, members); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting members: %s", err))
+	}
+
+	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
+		ID: &lbID,
+	}
+	lb, response, err := sess.GetLoadBalancerWithContext(context, getLoadBalancerOptions)
+	if err != nil {
+		return flex.APIErrorDiag(err, response, "vpc", "GetLoadBalancer", "ibm_is_lb_pool_members", d.Id())
+	}
+	d.Set(flex.RelatedCRN, *lb.CRN)
+	return nil
+}
+
+func resourceIBMISLBPoolMembersUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isLBPoolMembers) {
+		lbID, lbPoolID, err := parseIBMISLBPoolMembersID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := replaceIBMISLBPoolMembers(context, d, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return flex.DiagFromErr(err)
+		}
+	}
+	return resourceIBMISLBPoolMembersRead(context, d, meta)
+}
+
+func resourceIBMISLBPoolMembersDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbID, lbPoolID, err := parseIBMISLBPoolMembersID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(isLBPoolMembers, nil)
+	if err := replaceIBMISLBPoolMembers(context, d, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if flex.ErrorClassOf(err) == flex.ErrorClassNotFound {
+			d.SetId("")
+			return nil
+		}
+		return flex.DiagFromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// replaceIBMISLBPoolMembers replaces all the members of the pool by those of
+// the configuration in a single call, so that the load balancer goes through a
+// single update however many members change.
+func replaceIBMISLBPoolMembers(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string, timeout time.Duration) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	members := []vpcv1.LoadBalancerPoolMemberPrototype{}
+	for _, v := range d.Get(isLBPoolMembers).(*schema.Set).List() {
+		member, err := expandIBMISLBPoolMemberPrototype(v.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		members = append(members, member)
+	}
+
+	isLBKey := "load_balancer_key_" + lbID
+	unlock, err := conns.IbmLockManager.Lock(context, meta, isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
+	}
+	_, err = isWaitForLBAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
+	}
+
+	log.Printf("[INFO] Replacing the members of load balancer pool %s with %d members", lbPoolID, len(members))
+	replaceLoadBalancerPoolMembersOptions := &vpcv1.ReplaceLoadBalancerPoolMembersOptions{
+		LoadBalancerID: &lbID,
+		PoolID:         &lbPoolID,
+		Members:        members,
+	}
+	_, response, err := sess.ReplaceLoadBalancerPoolMembersWithContext(context, replaceLoadBalancerPoolMembersOptions)
+	if err != nil {
+		return flex.NewAPIError(err, response, "vpc", "ReplaceLoadBalancerPoolMembers", "ibm_is_lb_pool_members", fmt.Sprintf("%s/%s", lbID, lbPoolID))
+	}
+
+	_, err = isWaitForLBAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
+	}
+	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
+	}
+	return nil
+}
+
+func expandIBMISLBPoolMemberPrototype(m map[string]interface{}) (vpcv1.LoadBalancerPoolMemberPrototype, error) {
+	port := int64(m[isLBPoolMemberPort].(int))
+	weight := int64(m[isLBPoolMemberWeight].(int))
+	member := vpcv1.LoadBalancerPoolMemberPrototype{
+		Port:   &port,
+		Weight: &weight,
+	}
+	address, _ := m[isLBPoolMemberTargetAddress].(string)
+	targetID, _ := m[isLBPoolMemberTargetID].(string)
+	switch {
+	case address != "" && targetID == "":
+		member.Target = &vpcv1.LoadBalancerPoolMemberTargetPrototype{
+			Address: &address,
+		}
+	case targetID != "" && address == "":
+		member.Target = &vpcv1.LoadBalancerPoolMemberTargetPrototype{
+			ID: &targetID,
+		}
+	default:
+		return member, fmt.Errorf("[ERROR] Error in the member on port %d: exactly one of %s or %s must be specified", port, isLBPoolMemberTargetAddress, isLBPoolMemberTargetID)
+	}
+	return member, nil
+}
+
+func flattenIBMISLBPoolMember(member vpcv1.LoadBalancerPoolMember) map[string]interface{} {
+	m := map[string]interface{}{
+		isLBPoolMemberID:                 *member.ID,
+		isLBPoolMemberPort:               int(*member.Port),
+		isLBPoolMemberWeight:             isLBPoolMemberWeightDefault,
+		isLBPoolMemberProvisioningStatus: *member.ProvisioningStatus,
+		isLBPoolMemberHealth:             *member.Health,
+		isLBPoolMemberHref:               *member.Href,
+	}
+	if member.Weight != nil {
+		m[isLBPoolMemberWeight] = int(*member.Weight)
+	}
+	if target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok {
+		if target.Address != nil {
+			m[isLBPoolMemberTargetAddress] = *target.Address
+		}
+		if target.ID != nil {
+			m[isLBPoolMemberTargetID] = *target.ID
+		}
+	}
+	return m
+}
+
+func parseIBMISLBPoolMembersID(id string) (lbID, lbPoolID string, err error) {
+	parts, err := flex.IdParts(id)
+	if err != nil {
+		return "", "", err
+	}
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("[ERROR] The id %q should be <load balancer ID>/<pool ID>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceIBMISLBPoolMembersHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	address, _ := m[isLBPoolMemberTargetAddress].(string)
+	targetID, _ := m[isLBPoolMemberTargetID].(string)
+	return schema.HashString(fmt.Sprintf("%v|%s|%s|%v", m[isLBPoolMemberPort], address, targetID, m[isLBPoolMemberWeight]))
+}
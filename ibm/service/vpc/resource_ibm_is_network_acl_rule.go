@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -40,7 +41,7 @@ func ResourceIBMISNetworkACLRule() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -330,8 +331,14 @@ func ResourceIBMISNetworkACLRuleValidator() *validate.ResourceValidator {
 
 func resourceIBMISNetworkACLRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	nwACLID := d.Get(isNwACLID).(string)
+	isNwACLKey := "network_acl_key_" + nwACLID
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutCreate, isNwACLKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
-	err := nwaclRuleCreate(d, meta, nwACLID)
+	err = nwaclRuleCreate(d, meta, nwACLID)
 	if err != nil {
 		return err
 	}
@@ -579,6 +586,15 @@ func nwaclRuleGet(d *schema.ResourceData, meta interface{}, nwACLID string, nwac
 func resourceIBMISNetworkACLRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 	nwACLId, ruleId, err := parseNwACLTerraformID(id)
+	if err != nil {
+		return err
+	}
+	isNwACLKey := "network_acl_key_" + nwACLId
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutUpdate, isNwACLKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	err = nwaclRuleUpdate(d, meta, ruleId, nwACLId)
 	if err != nil {
@@ -763,6 +779,12 @@ func resourceIBMISNetworkACLRuleDelete(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+	isNwACLKey := "network_acl_key_" + nwACLID
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutDelete, isNwACLKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	err = nwaclRuleDelete(d, meta, ruleId, nwACLID)
 	if err != nil {
@@ -0,0 +1,783 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isNetworkACLRulesID       = "id"
+	isNetworkACLRulesShadowed = "shadowed_rules"
+
+	// The quota of the rules of a network ACL in each direction
+	isNetworkACLRulesQuota = 25
+)
+
+func ResourceIBMISNetworkACLRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISNetworkACLRulesCreate,
+		ReadContext:   resourceIBMISNetworkACLRulesRead,
+		UpdateContext: resourceIBMISNetworkACLRulesUpdate,
+		DeleteContext: resourceIBMISNetworkACLRulesDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: resourceIBMISNetworkACLRulesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			isNwACLID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Network ACL id",
+			},
+
+			isNetworkACLRules: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The complete ordered list of rules of the network ACL, the rules not listed are removed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isNetworkACLRulesID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Rule id",
+						},
+						isNetworkACLRuleName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The user-defined name for this rule, unique within the network ACL",
+							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleName),
+						},
+						isNetworkACLRuleAction: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Whether to allow or deny matching traffic",
+							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleAction),
+						},
+						isNetworkACLRuleDirection: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Direction of traffic to enforce, either inbound or outbound",
+							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleDirection),
+						},
+						isNetworkACLRuleSource: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The source CIDR block. The CIDR block 0.0.0.0/0 applies to all addresses.",
+							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleSource),
+						},
+						isNetworkACLRuleDestination: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The destination CIDR block. The CIDR block 0.0.0.0/0 applies to all addresses.",
+							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleDestination),
+						},
+						isNetworkACLRuleICMP: {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									isNetworkACLRuleICMPCode: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleICMPCode),
+										Description:  "The ICMP traffic code to allow. Valid values from 0 to 255.",
+									},
+									isNetworkACLRuleICMPType: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleICMPType),
+										Description:  "The ICMP traffic type to allow. Valid values from 0 to 254.",
+									},
+								},
+							},
+						},
+						isNetworkACLRuleTCP: {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem:     resourceIBMISNetworkACLRulesPortRange(),
+						},
+						isNetworkACLRuleUDP: {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem:     resourceIBMISNetworkACLRulesPortRange(),
+						},
+						isNetworkACLRuleProtocol: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The protocol of the rule.",
+						},
+					},
+				},
+			},
+
+			isNetworkACLRulesShadowed: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the rules that never match because an earlier rule with the opposite action matches all their traffic",
+			},
+		},
+	}
+}
+
+func resourceIBMISNetworkACLRulesPortRange() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isNetworkACLRulePortMax: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      65535,
+				ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRulePortMax),
+				Description:  "The highest port in the range of ports to be matched",
+			},
+			isNetworkACLRulePortMin: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRulePortMin),
+				Description:  "The lowest port in the range of ports to be matched",
+			},
+			isNetworkACLRuleSourcePortMax: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      65535,
+				ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleSourcePortMax),
+				Description:  "The highest port in the range of ports to be matched",
+			},
+			isNetworkACLRuleSourcePortMin: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleSourcePortMin),
+				Description:  "The lowest port in the range of ports to be matched",
+			},
+		},
+	}
+}
+
+// resourceIBMISNetworkACLRulesCustomizeDiff rejects duplicate rule names and
+// reports the shadowed rules in the plan. A CustomizeDiff of SDK v2 cannot
+// emit warnings, so the computed shadowed_rules attribute is the plan-time
+// signal; the warnings are emitted at apply by networkACLShadowedRulesDiags.
+// The rules with addresses not known until apply are left out of the
+// shadowing check.
+func resourceIBMISNetworkACLRulesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	rules := diff.Get(isNetworkACLRules).([]interface{})
+	names := map[string]bool{}
+	known := make([]networkACLRuleSpec, 0, len(rules))
+	for i, v := range rules {
+		if v == nil {
+			continue
+		}
+		rule := expandNetworkACLRuleSpec(v.(map[string]interface{}))
+		if rule.name != "" && diff.NewValueKnown(fmt.Sprintf("%s.%d.%s", isNetworkACLRules, i, isNetworkACLRuleName)) {
+			if names[rule.name] {
+				return fmt.Errorf("[ERROR] Error in the rules of network ACL: the name %s is used by more than one rule", rule.name)
+			}
+			names[rule.name] = true
+		}
+		if diff.NewValueKnown(fmt.Sprintf("%s.%d.%s", isNetworkACLRules, i, isNetworkACLRuleSource)) &&
+			diff.NewValueKnown(fmt.Sprintf("%s.%d.%s", isNetworkACLRules, i, isNetworkACLRuleDestination)) {
+			known = append(known, rule)
+		}
+	}
+	return diff.SetNew(isNetworkACLRulesShadowed, shadowedNetworkACLRuleNames(known))
+}
+
+func resourceIBMISNetworkACLRulesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	nwACLID := d.Get(isNwACLID).(string)
+	if err := applyIBMISNetworkACLRules(context, d, meta, nwACLID); err != nil {
+		return flex.DiagFromErr(err)
+	}
+	d.SetId(nwACLID)
+	return append(resourceIBMISNetworkACLRulesRead(context, d, meta), networkACLShadowedRulesDiags(d)...)
+}
+
+func resourceIBMISNetworkACLRulesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+	current, response, err := listIBMISNetworkACLRules(context, sess, id)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return flex.APIErrorDiag(err, response, "vpc", "ListNetworkACLRules", "ibm_is_network_acl_rules", id)
+	}
+
+	rules := make([]interface{}, 0, len(current))
+	for _, rule := range current {
+		rules = append(rules, rule.toMap())
+	}
+	d.Set(isNwACLID, id)
+	if err = d.Set(isNetworkACLRules, rules); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting rules: %s", err))
+	}
+	d.Set(isNetworkACLRulesShadowed, shadowedNetworkACLRuleNames(current))
+	return nil
+}
+
+func resourceIBMISNetworkACLRulesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isNetworkACLRules) {
+		if err := applyIBMISNetworkACLRules(context, d, meta, d.Id()); err != nil {
+			return flex.DiagFromErr(err)
+		}
+	}
+	return append(resourceIBMISNetworkACLRulesRead(context, d, meta), networkACLShadowedRulesDiags(d)...)
+}
+
+func resourceIBMISNetworkACLRulesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.Set(isNetworkACLRules, nil)
+	if err := applyIBMISNetworkACLRules(context, d, meta, d.Id()); err != nil {
+		// The rules are gone with their network ACL
+		if flex.ErrorClassOf(err) == flex.ErrorClassNotFound {
+			log.Printf("[DEBUG] Network ACL %s is already deleted", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return flex.DiagFromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// networkACLShadowedRulesDiags warns of the shadowed rules once applied, the
+// plan only shows them in shadowed_rules.
+func networkACLShadowedRulesDiags(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, name := range d.Get(isNetworkACLRulesShadowed).([]interface{}) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Network ACL rule %s never matches", name),
+			Detail:   fmt.Sprintf("An earlier rule of network ACL %s with the opposite action matches all the traffic of rule %s.", d.Id(), name),
+		})
+	}
+	return diags
+}
+
+// applyIBMISNetworkACLRules makes the rules of the network ACL the ordered
+// rules of the configuration. The rules are matched by name; the matched rules
+// are updated in place and the longest run of them already in the right order
+// stays where it is, so that only the other rules are moved. The new rules are
+// created before the rules no longer configured are deleted, unless the network
+// ACL has no room left for them, and the rules are set with their IDs once
+// applied.
+func applyIBMISNetworkACLRules(context context.Context, d *schema.ResourceData, meta interface{}, nwACLID string) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	// The rules are reordered with several calls, the ibm_is_network_acl_rule
+	// resources of the same network ACL wait for them to complete
+	isNwACLKey := "network_acl_key_" + nwACLID
+	unlock, err := conns.IbmLockManager.Lock(context, meta, isNwACLKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	desired := make([]networkACLRuleSpec, 0)
+	for _, v := range d.Get(isNetworkACLRules).([]interface{}) {
+		if v != nil {
+			desired = append(desired, expandNetworkACLRuleSpec(v.(map[string]interface{})))
+		}
+	}
+
+	current, response, err := listIBMISNetworkACLRules(context, sess, nwACLID)
+	if err != nil {
+		return flex.NewAPIError(err, response, "vpc", "ListNetworkACLRules", "ibm_is_network_acl_rules", nwACLID)
+	}
+	position := map[string]int{}
+	for i, rule := range current {
+		position[rule.name] = i
+	}
+	// The current position of each desired rule, -1 for the rules to create
+	matched := make([]int, len(desired))
+	configured := map[string]bool{}
+	for i, rule := range desired {
+		configured[rule.name] = true
+		matched[i] = -1
+		if p, ok := position[rule.name]; ok {
+			matched[i] = p
+		}
+	}
+	inPlace := longestIncreasingPositions(matched)
+	log.Printf("[INFO] Changing the rules of network ACL %s: %d of %d rules stay in place", nwACLID, len(inPlace), len(desired))
+
+	// The rules no longer configured are deleted last, or first when the new
+	// rules don't fit in the quota of the network ACL
+	unconfiguredDeleted := false
+	deleteUnconfigured := func() error {
+		unconfiguredDeleted = true
+		for _, rule := range current {
+			if configured[rule.name] {
+				continue
+			}
+			deleteNetworkACLRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
+				NetworkACLID: &nwACLID,
+				ID:           core.StringPtr(rule.id),
+			}
+			response, err := sess.DeleteNetworkACLRuleWithContext(context, deleteNetworkACLRuleOptions)
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				return flex.NewAPIError(err, response, "vpc", "DeleteNetworkACLRule", "ibm_is_network_acl_rules", nwACLID)
+			}
+		}
+		return nil
+	}
+	if networkACLRulesOverQuota(current, desired, matched) {
+		log.Printf("[INFO] Network ACL %s has no room for the new rules, deleting the rules no longer configured first", nwACLID)
+		if err := deleteUnconfigured(); err != nil {
+			return err
+		}
+	}
+
+	// Place the rules from the last one, each immediately before the next one
+	next := ""
+	for i := len(desired) - 1; i >= 0; i-- {
+		rule := desired[i]
+		if matched[i] < 0 {
+			createNetworkACLRuleOptions := &vpcv1.CreateNetworkACLRuleOptions{
+				NetworkACLID:            &nwACLID,
+				NetworkACLRulePrototype: rule.prototype(next),
+			}
+			nwaclRule, response, err := sess.CreateNetworkACLRuleWithContext(context, createNetworkACLRuleOptions)
+			if err != nil && !unconfiguredDeleted && flex.NewAPIError(err, response, "vpc", "CreateNetworkACLRule", "ibm_is_network_acl_rules", nwACLID).Class == flex.ErrorClassQuota {
+				log.Printf("[INFO] Network ACL %s is at its quota of rules, deleting the rules no longer configured first", nwACLID)
+				if err := deleteUnconfigured(); err != nil {
+					return err
+				}
+				nwaclRule, response, err = sess.CreateNetworkACLRuleWithContext(context, createNetworkACLRuleOptions)
+			}
+			if err != nil {
+				return flex.NewAPIError(err, response, "vpc", "CreateNetworkACLRule", "ibm_is_network_acl_rules", nwACLID)
+			}
+			if created, ok := networkACLRuleSpecFromRule(nwaclRule); ok {
+				rule.id = created.id
+			}
+		} else {
+			existing := current[matched[i]]
+			rule.id = existing.id
+			move := !inPlace[i]
+			if move || rule.key() != existing.key() {
+				patch, err := rule.patch(move, next)
+				if err != nil {
+					return err
+				}
+				updateNetworkACLRuleOptions := &vpcv1.UpdateNetworkACLRuleOptions{
+					NetworkACLID:        &nwACLID,
+					ID:                  core.StringPtr(rule.id),
+					NetworkACLRulePatch: patch,
+				}
+				_, response, err := sess.UpdateNetworkACLRuleWithContext(context, updateNetworkACLRuleOptions)
+				if err != nil {
+					return flex.NewAPIError(err, response, "vpc", "UpdateNetworkACLRule", "ibm_is_network_acl_rules", nwACLID)
+				}
+			}
+		}
+		desired[i] = rule
+		next = rule.id
+	}
+
+	if !unconfiguredDeleted {
+		if err := deleteUnconfigured(); err != nil {
+			return err
+		}
+	}
+
+	applied := make([]interface{}, 0, len(desired))
+	for _, rule := range desired {
+		applied = append(applied, rule.toMap())
+	}
+	return d.Set(isNetworkACLRules, applied)
+}
+
+// networkACLRulesOverQuota tells if creating the new rules of desired before
+// deleting the current rules no longer configured exceeds the quota of rules
+// of a direction. matched is the current position of each desired rule, -1 for
+// the rules to create.
+func networkACLRulesOverQuota(current, desired []networkACLRuleSpec, matched []int) bool {
+	count := map[string]int{}
+	for _, rule := range current {
+		count[rule.direction]++
+	}
+	for i, rule := range desired {
+		if matched[i] < 0 {
+			count[rule.direction]++
+		}
+	}
+	for _, n := range count {
+		if n > isNetworkACLRulesQuota {
+			return true
+		}
+	}
+	return false
+}
+
+// longestIncreasingPositions returns the indexes of a longest strictly
+// increasing subsequence of the non-negative positions.
+func longestIncreasingPositions(positions []int) map[int]bool {
+	// tails[k] is the index ending the best subsequence of length k+1 found so far
+	tails := []int{}
+	previous := make([]int, len(positions))
+	for i, p := range positions {
+		previous[i] = -1
+		if p < 0 {
+			continue
+		}
+		lo, hi := 0, len(tails)
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if positions[tails[mid]] < p {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		if lo > 0 {
+			previous[i] = tails[lo-1]
+		}
+		if lo == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[lo] = i
+		}
+	}
+	result := map[int]bool{}
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = previous[i] {
+			result[i] = true
+		}
+	}
+	return result
+}
+
+func listIBMISNetworkACLRules(context context.Context, sess *vpcv1.VpcV1, nwACLID string) ([]networkACLRuleSpec, *core.DetailedResponse, error) {
+	start := ""
+	rules := []networkACLRuleSpec{}
+	listNetworkACLRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
+		NetworkACLID: &nwACLID,
+	}
+	for {
+		if start != "" {
+			listNetworkACLRulesOptions.Start = &start
+		}
+		ruleList, response, err := sess.ListNetworkACLRulesWithContext(context, listNetworkACLRulesOptions)
+		if err != nil {
+			return nil, response, err
+		}
+		for _, nwaclRule := range ruleList.Rules {
+			if rule, ok := networkACLRuleSpecFromRule(nwaclRule); ok {
+				rules = append(rules, rule)
+			}
+		}
+		start = flex.GetNext(ruleList.Next)
+		if start == "" {
+			break
+		}
+	}
+	return rules, nil, nil
+}
+
+// networkACLRuleSpec is a network ACL rule independently of its protocol.
+// Unset ICMP type and code are nil, and TCP and UDP rules always have ports.
+type networkACLRuleSpec struct {
+	id            string
+	name          string
+	action        string
+	direction     string
+	source        string
+	destination   string
+	protocol      string
+	icmpType      *int64
+	icmpCode      *int64
+	portMin       int64
+	portMax       int64
+	sourcePortMin int64
+	sourcePortMax int64
+}
+
+// key identifies what the rule does, regardless of its ID and name.
+func (r networkACLRuleSpec) key() string {
+	parts := []string{r.action, r.direction, r.source, r.destination, r.protocol}
+	switch r.protocol {
+	case isNetworkACLRuleICMP:
+		parts = append(parts, optionalInt64String(r.icmpType), optionalInt64String(r.icmpCode))
+	case isNetworkACLRuleTCP, isNetworkACLRuleUDP:
+		parts = append(parts, fmt.Sprint(r.portMin), fmt.Sprint(r.portMax), fmt.Sprint(r.sourcePortMin), fmt.Sprint(r.sourcePortMax))
+	}
+	return strings.Join(parts, "|")
+}
+
+// covers tells if the rule matches all the traffic the other rule matches.
+func (r networkACLRuleSpec) covers(other networkACLRuleSpec) bool {
+	if r.direction != other.direction ||
+		!networkACLAddressContains(r.source, other.source) ||
+		!networkACLAddressContains(r.destination, other.destination) {
+		return false
+	}
+	switch r.protocol {
+	case "all":
+		return true
+	case isNetworkACLRuleICMP:
+		return other.protocol == isNetworkACLRuleICMP &&
+			(r.icmpType == nil || (other.icmpType != nil && *r.icmpType == *other.icmpType)) &&
+			(r.icmpCode == nil || (other.icmpCode != nil && *r.icmpCode == *other.icmpCode))
+	default:
+		return other.protocol == r.protocol &&
+			r.portMin <= other.portMin && other.portMax <= r.portMax &&
+			r.sourcePortMin <= other.sourcePortMin && other.sourcePortMax <= r.sourcePortMax
+	}
+}
+
+func (r networkACLRuleSpec) prototype(before string) *vpcv1.NetworkACLRulePrototype {
+	prototype := &vpcv1.NetworkACLRulePrototype{
+		Name:        core.StringPtr(r.name),
+		Action:      core.StringPtr(r.action),
+		Direction:   core.StringPtr(r.direction),
+		Source:      core.StringPtr(r.source),
+		Destination: core.StringPtr(r.destination),
+		Protocol:    core.StringPtr(r.protocol),
+	}
+	if before != "" {
+		prototype.Before = &vpcv1.NetworkACLRuleBeforePrototype{
+			ID: core.StringPtr(before),
+		}
+	}
+	switch r.protocol {
+	case isNetworkACLRuleICMP:
+		prototype.Type = r.icmpType
+		prototype.Code = r.icmpCode
+	case isNetworkACLRuleTCP, isNetworkACLRuleUDP:
+		prototype.DestinationPortMin = core.Int64Ptr(r.portMin)
+		prototype.DestinationPortMax = core.Int64Ptr(r.portMax)
+		prototype.SourcePortMin = core.Int64Ptr(r.sourcePortMin)
+		prototype.SourcePortMax = core.Int64Ptr(r.sourcePortMax)
+	}
+	return prototype
+}
+
+// patch sets everything the rule does and, when move is set, moves it
+// immediately before the rule with the given ID, or last if there is none.
+func (r networkACLRuleSpec) patch(move bool, before string) (map[string]interface{}, error) {
+	networkACLRulePatchModel := &vpcv1.NetworkACLRulePatch{
+		Action:      core.StringPtr(r.action),
+		Direction:   core.StringPtr(r.direction),
+		Source:      core.StringPtr(r.source),
+		Destination: core.StringPtr(r.destination),
+		Protocol:    core.StringPtr(r.protocol),
+	}
+	if move && before != "" {
+		networkACLRulePatchModel.Before = &vpcv1.NetworkACLRuleBeforePatchNetworkACLRuleIdentityByID{
+			ID: core.StringPtr(before),
+		}
+	}
+	switch r.protocol {
+	case isNetworkACLRuleICMP:
+		networkACLRulePatchModel.Type = r.icmpType
+		networkACLRulePatchModel.Code = r.icmpCode
+	case isNetworkACLRuleTCP, isNetworkACLRuleUDP:
+		networkACLRulePatchModel.DestinationPortMin = core.Int64Ptr(r.portMin)
+		networkACLRulePatchModel.DestinationPortMax = core.Int64Ptr(r.portMax)
+		networkACLRulePatchModel.SourcePortMin = core.Int64Ptr(r.sourcePortMin)
+		networkACLRulePatchModel.SourcePortMax = core.Int64Ptr(r.sourcePortMax)
+	}
+	networkACLRulePatch, err := networkACLRulePatchModel.AsPatch()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLRulePatch: %s", err)
+	}
+	if move && before == "" {
+		networkACLRulePatch["before"] = nil
+	}
+	if r.protocol == isNetworkACLRuleICMP {
+		if r.icmpType == nil {
+			networkACLRulePatch["type"] = nil
+		}
+		if r.icmpCode == nil {
+			networkACLRulePatch["code"] = nil
+		}
+	}
+	return networkACLRulePatch, nil
+}
+
+func (r networkACLRuleSpec) toMap() map[string]interface{} {
+	m := map[string]interface{}{
+		isNetworkACLRulesID:         r.id,
+		isNetworkACLRuleName:        r.name,
+		isNetworkACLRuleAction:      r.action,
+		isNetworkACLRuleDirection:   r.direction,
+		isNetworkACLRuleSource:      r.source,
+		isNetworkACLRuleDestination: r.destination,
+		isNetworkACLRuleProtocol:    r.protocol,
+	}
+	switch r.protocol {
+	case isNetworkACLRuleICMP:
+		icmp := map[string]interface{}{}
+		if r.icmpType != nil {
+			icmp[isNetworkACLRuleICMPType] = int(*r.icmpType)
+		}
+		if r.icmpCode != nil {
+			icmp[isNetworkACLRuleICMPCode] = int(*r.icmpCode)
+		}
+		m[isNetworkACLRuleICMP] = []interface{}{icmp}
+	case isNetworkACLRuleTCP, isNetworkACLRuleUDP:
+		m[r.protocol] = []interface{}{map[string]interface{}{
+			isNetworkACLRulePortMin:       int(r.portMin),
+			isNetworkACLRulePortMax:       int(r.portMax),
+			isNetworkACLRuleSourcePortMin: int(r.sourcePortMin),
+			isNetworkACLRuleSourcePortMax: int(r.sourcePortMax),
+		}}
+	}
+	return m
+}
+
+// expandNetworkACLRuleSpec reads a rule as the ibm_is_network_acl_rule
+// resource does: an empty icmp block matches all the ICMP traffic, otherwise
+// both its type and code are set.
+func expandNetworkACLRuleSpec(m map[string]interface{}) networkACLRuleSpec {
+	rule := networkACLRuleSpec{
+		protocol: "all",
+	}
+	rule.id, _ = m[isNetworkACLRulesID].(string)
+	rule.name, _ = m[isNetworkACLRuleName].(string)
+	rule.action, _ = m[isNetworkACLRuleAction].(string)
+	rule.direction, _ = m[isNetworkACLRuleDirection].(string)
+	rule.source, _ = m[isNetworkACLRuleSource].(string)
+	rule.destination, _ = m[isNetworkACLRuleDestination].(string)
+	if icmp, ok := m[isNetworkACLRuleICMP].([]interface{}); ok && len(icmp) > 0 {
+		rule.protocol = isNetworkACLRuleICMP
+		if !isNil(icmp[0]) {
+			values := icmp[0].(map[string]interface{})
+			if v, ok := values[isNetworkACLRuleICMPType].(int); ok {
+				rule.icmpType = core.Int64Ptr(int64(v))
+			}
+			if v, ok := values[isNetworkACLRuleICMPCode].(int); ok {
+				rule.icmpCode = core.Int64Ptr(int64(v))
+			}
+		}
+	}
+	for _, protocol := range []string{isNetworkACLRuleTCP, isNetworkACLRuleUDP} {
+		ports, ok := m[protocol].([]interface{})
+		if !ok || len(ports) == 0 {
+			continue
+		}
+		rule.protocol = protocol
+		rule.portMin, rule.portMax, rule.sourcePortMin, rule.sourcePortMax = 1, 65535, 1, 65535
+		if !isNil(ports[0]) {
+			values := ports[0].(map[string]interface{})
+			if v, ok := values[isNetworkACLRulePortMin].(int); ok {
+				rule.portMin = int64(v)
+			}
+			if v, ok := values[isNetworkACLRulePortMax].(int); ok {
+				rule.portMax = int64(v)
+			}
+			if v, ok := values[isNetworkACLRuleSourcePortMin].(int); ok {
+				rule.sourcePortMin = int64(v)
+			}
+			if v, ok := values[isNetworkACLRuleSourcePortMax].(int); ok {
+				rule.sourcePortMax = int64(v)
+			}
+		}
+	}
+	return rule
+}
+
+// networkACLRuleSpecFromRule reads both the rules the API returns in lists and
+// those it returns on their own.
+func networkACLRuleSpecFromRule(nwaclRule interface{}) (networkACLRuleSpec, bool) {
+	rule := networkACLRuleSpec{}
+	switch r := nwaclRule.(type) {
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+		rule.id, rule.name, rule.action, rule.direction = *r.ID, *r.Name, *r.Action, *r.Direction
+		rule.source, rule.destination, rule.protocol = *r.Source, *r.Destination, *r.Protocol
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+		rule.id, rule.name, rule.action, rule.direction = *r.ID, *r.Name, *r.Action, *r.Direction
+		rule.source, rule.destination, rule.protocol = *r.Source, *r.Destination, *r.Protocol
+		rule.icmpType, rule.icmpCode = r.Type, r.Code
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+		rule.id, rule.name, rule.action, rule.direction = *r.ID, *r.Name, *r.Action, *r.Direction
+		rule.source, rule.destination, rule.protocol = *r.Source, *r.Destination, *r.Protocol
+		rule.portMin, rule.portMax = int64(checkNetworkACLNil(r.DestinationPortMin)), int64(checkNetworkACLNil(r.DestinationPortMax))
+		rule.sourcePortMin, rule.sourcePortMax = int64(checkNetworkACLNil(r.SourcePortMin)), int64(checkNetworkACLNil(r.SourcePortMax))
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolAll:
+		rule.id, rule.name, rule.action, rule.direction = *r.ID, *r.Name, *r.Action, *r.Direction
+		rule.source, rule.destination, rule.protocol = *r.Source, *r.Destination, *r.Protocol
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolIcmp:
+		rule.id, rule.name, rule.action, rule.direction = *r.ID, *r.Name, *r.Action, *r.Direction
+		rule.source, rule.destination, rule.protocol = *r.Source, *r.Destination, *r.Protocol
+		rule.icmpType, rule.icmpCode = r.Type, r.Code
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolTcpudp:
+		rule.id, rule.name, rule.action, rule.direction = *r.ID, *r.Name, *r.Action, *r.Direction
+		rule.source, rule.destination, rule.protocol = *r.Source, *r.Destination, *r.Protocol
+		rule.portMin, rule.portMax = int64(checkNetworkACLNil(r.DestinationPortMin)), int64(checkNetworkACLNil(r.DestinationPortMax))
+		rule.sourcePortMin, rule.sourcePortMax = int64(checkNetworkACLNil(r.SourcePortMin)), int64(checkNetworkACLNil(r.SourcePortMax))
+	default:
+		return rule, false
+	}
+	return rule, true
+}
+
+// shadowedNetworkACLRuleNames returns the names of the rules all the traffic of
+// which is matched by an earlier rule with the opposite action.
+func shadowedNetworkACLRuleNames(rules []networkACLRuleSpec) []interface{} {
+	shadowed := []interface{}{}
+	for i, rule := range rules {
+		for _, earlier := range rules[:i] {
+			if earlier.action != rule.action && earlier.covers(rule) {
+				log.Printf("[WARN] Network ACL rule %s never matches: the earlier rule %s with action %s matches all its traffic", rule.name, earlier.name, earlier.action)
+				shadowed = append(shadowed, rule.name)
+				break
+			}
+		}
+	}
+	return shadowed
+}
+
+// networkACLAddressContains tells if the outer IP address or CIDR block
+// contains the inner one.
+func networkACLAddressContains(outer, inner string) bool {
+	outerNet, ok := networkACLAddressNet(outer)
+	if !ok {
+		return false
+	}
+	innerNet, ok := networkACLAddressNet(inner)
+	if !ok {
+		return false
+	}
+	outerOnes, outerBits := outerNet.Mask.Size()
+	innerOnes, innerBits := innerNet.Mask.Size()
+	return outerBits == innerBits && outerOnes <= innerOnes && outerNet.Contains(innerNet.IP)
+}
+
+func networkACLAddressNet(address string) (*net.IPNet, bool) {
+	if _, ipNet, err := net.ParseCIDR(address); err == nil {
+		return ipNet, true
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return nil, false
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, true
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, true
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestLongestIncreasingPositions(t *testing.T) {
+	cases := []struct {
+		name      string
+		positions []int
+		expected  map[int]bool
+	}{
+		{name: "no rules", positions: nil, expected: map[int]bool{}},
+		{name: "only new rules", positions: []int{-1, -1}, expected: map[int]bool{}},
+		{name: "unchanged order", positions: []int{0, 1, 2}, expected: map[int]bool{0: true, 1: true, 2: true}},
+		{name: "reversed order keeps one rule", positions: []int{2, 1, 0}, expected: map[int]bool{2: true}},
+		{name: "last rule moved first", positions: []int{3, 0, 1, 2}, expected: map[int]bool{1: true, 2: true, 3: true}},
+		{name: "first rule moved last", positions: []int{1, 2, 3, 0}, expected: map[int]bool{0: true, 1: true, 2: true}},
+		{name: "new rules are skipped", positions: []int{0, -1, 1, -1, 2}, expected: map[int]bool{0: true, 2: true, 4: true}},
+		{name: "deleted rules leave gaps", positions: []int{0, 4, 2, 5}, expected: map[int]bool{0: true, 2: true, 3: true}},
+	}
+	for _, c := range cases {
+		if inPlace := longestIncreasingPositions(c.positions); !reflect.DeepEqual(inPlace, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, inPlace)
+		}
+	}
+}
+
+func TestShadowedNetworkACLRuleNames(t *testing.T) {
+	all := func(name, action, source string) networkACLRuleSpec {
+		return networkACLRuleSpec{name: name, action: action, direction: "inbound", source: source, destination: "0.0.0.0/0", protocol: "all"}
+	}
+	tcp := func(name, action, source string, min, max int64) networkACLRuleSpec {
+		return networkACLRuleSpec{name: name, action: action, direction: "inbound", source: source, destination: "0.0.0.0/0", protocol: isNetworkACLRuleTCP,
+			portMin: min, portMax: max, sourcePortMin: 1, sourcePortMax: 65535}
+	}
+	icmp := func(name, action string, icmpType *int64) networkACLRuleSpec {
+		return networkACLRuleSpec{name: name, action: action, direction: "inbound", source: "0.0.0.0/0", destination: "0.0.0.0/0", protocol: isNetworkACLRuleICMP, icmpType: icmpType}
+	}
+	outbound := func(rule networkACLRuleSpec) networkACLRuleSpec {
+		rule.direction = "outbound"
+		return rule
+	}
+	cases := []struct {
+		name     string
+		rules    []networkACLRuleSpec
+		expected []interface{}
+	}{
+		{
+			name:     "deny all before an allow",
+			rules:    []networkACLRuleSpec{all("deny-all", "deny", "0.0.0.0/0"), tcp("allow-ssh", "allow", "10.0.0.0/8", 22, 22)},
+			expected: []interface{}{"allow-ssh"},
+		},
+		{
+			name:     "allow before a deny all",
+			rules:    []networkACLRuleSpec{tcp("allow-ssh", "allow", "10.0.0.0/8", 22, 22), all("deny-all", "deny", "0.0.0.0/0")},
+			expected: []interface{}{},
+		},
+		{
+			name:     "an earlier rule with the same action",
+			rules:    []networkACLRuleSpec{all("allow-all", "allow", "0.0.0.0/0"), tcp("allow-ssh", "allow", "10.0.0.0/8", 22, 22)},
+			expected: []interface{}{},
+		},
+		{
+			name:     "a narrower source",
+			rules:    []networkACLRuleSpec{all("deny-subnet", "deny", "10.0.1.0/24"), tcp("allow-ssh", "allow", "10.0.0.0/8", 22, 22)},
+			expected: []interface{}{},
+		},
+		{
+			name:     "a single address in a CIDR block",
+			rules:    []networkACLRuleSpec{all("deny-subnet", "deny", "10.0.1.0/24"), tcp("allow-host", "allow", "10.0.1.5", 22, 22)},
+			expected: []interface{}{"allow-host"},
+		},
+		{
+			name:     "port ranges",
+			rules:    []networkACLRuleSpec{tcp("deny-low", "deny", "0.0.0.0/0", 1, 1024), tcp("allow-ssh", "allow", "0.0.0.0/0", 22, 22), tcp("allow-web", "allow", "0.0.0.0/0", 443, 8443)},
+			expected: []interface{}{"allow-ssh"},
+		},
+		{
+			name:     "any ICMP type",
+			rules:    []networkACLRuleSpec{icmp("deny-icmp", "deny", nil), icmp("allow-ping", "allow", core.Int64Ptr(8))},
+			expected: []interface{}{"allow-ping"},
+		},
+		{
+			name:     "one ICMP type",
+			rules:    []networkACLRuleSpec{icmp("deny-ping", "deny", core.Int64Ptr(8)), icmp("allow-icmp", "allow", nil)},
+			expected: []interface{}{},
+		},
+		{
+			name:     "another direction",
+			rules:    []networkACLRuleSpec{outbound(all("deny-all", "deny", "0.0.0.0/0")), tcp("allow-ssh", "allow", "0.0.0.0/0", 22, 22)},
+			expected: []interface{}{},
+		},
+	}
+	for _, c := range cases {
+		if shadowed := shadowedNetworkACLRuleNames(c.rules); !reflect.DeepEqual(shadowed, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, shadowed)
+		}
+	}
+}
+
+func TestNetworkACLRulesOverQuota(t *testing.T) {
+	rules := func(direction string, n int) []networkACLRuleSpec {
+		rules := make([]networkACLRuleSpec, n)
+		for i := range rules {
+			rules[i] = networkACLRuleSpec{direction: direction}
+		}
+		return rules
+	}
+	created := func(n int) []int {
+		matched := make([]int, n)
+		for i := range matched {
+			matched[i] = -1
+		}
+		return matched
+	}
+	if networkACLRulesOverQuota(rules("inbound", 20), rules("inbound", 5), created(5)) {
+		t.Error("expected 25 inbound rules to fit")
+	}
+	if !networkACLRulesOverQuota(rules("inbound", 20), rules("inbound", 6), created(6)) {
+		t.Error("expected 26 inbound rules to exceed the quota")
+	}
+	if networkACLRulesOverQuota(rules("inbound", 20), rules("outbound", 6), created(6)) {
+		t.Error("expected the quota to be counted by direction")
+	}
+	if networkACLRulesOverQuota(rules("inbound", 25), rules("inbound", 25), make([]int, 25)) {
+		t.Error("expected the rules already in place not to count twice")
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISNetworkACLRules_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfnwaclrules-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfnwaclrules-acl-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISNetworkACLRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISNetworkACLRulesConfig(vpcname, name, "ssh", "icmp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISNetworkACLRulesOrder("ibm_is_network_acl_rules.testacc_acl_rules", "ssh", "icmp", "deny-all"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl_rules.testacc_acl_rules", "rules.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl_rules.testacc_acl_rules", "shadowed_rules.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISNetworkACLRulesConfig(vpcname, name, "icmp", "ssh"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISNetworkACLRulesOrder("ibm_is_network_acl_rules.testacc_acl_rules", "icmp", "ssh", "deny-all"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl_rules.testacc_acl_rules", "rules.0.name", "icmp"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISNetworkACLRulesShadowedConfig(vpcname, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISNetworkACLRulesOrder("ibm_is_network_acl_rules.testacc_acl_rules", "deny-all", "ssh"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl_rules.testacc_acl_rules", "shadowed_rules.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl_rules.testacc_acl_rules", "shadowed_rules.0", "ssh"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_network_acl_rules.testacc_acl_rules",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISNetworkACLRulesDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_network_acl_rules" {
+			continue
+		}
+
+		listNetworkACLRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
+			NetworkACLID: &rs.Primary.ID,
+		}
+		rules, _, err := sess.ListNetworkACLRules(listNetworkACLRulesOptions)
+		if err == nil && len(rules.Rules) > 0 {
+			return fmt.Errorf("network ACL %s still has %d rules", rs.Primary.ID, len(rules.Rules))
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISNetworkACLRulesOrder(n string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		listNetworkACLRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
+			NetworkACLID: &rs.Primary.ID,
+		}
+		rules, _, err := sess.ListNetworkACLRules(listNetworkACLRulesOptions)
+		if err != nil {
+			return err
+		}
+		if len(rules.Rules) != len(names) {
+			return fmt.Errorf("expected %d rules in network ACL %s, got %d", len(names), rs.Primary.ID, len(rules.Rules))
+		}
+		for i, rule := range rules.Rules {
+			var name string
+			switch rulex := rule.(type) {
+			case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+				name = *rulex.Name
+			case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+				name = *rulex.Name
+			case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+				name = *rulex.Name
+			}
+			if name != names[i] {
+				return fmt.Errorf("expected rule %d of network ACL %s to be %s, got %s", i, rs.Primary.ID, names[i], name)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISNetworkACLRulesBaseConfig(vpcname, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_network_acl" "testacc_acl" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}`, vpcname, name)
+}
+
+func testAccCheckIBMISNetworkACLRulesConfig(vpcname, name, first, second string) string {
+	rules := map[string]string{
+		"ssh": `
+		rules {
+			name        = "ssh"
+			action      = "allow"
+			direction   = "inbound"
+			source      = "10.0.0.0/8"
+			destination = "0.0.0.0/0"
+			tcp {
+				port_min = 22
+				port_max = 22
+			}
+		}`,
+		"icmp": `
+		rules {
+			name        = "icmp"
+			action      = "allow"
+			direction   = "inbound"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			icmp {
+				type = 8
+				code = 0
+			}
+		}`,
+	}
+	return testAccCheckIBMISNetworkACLRulesBaseConfig(vpcname, name) + fmt.Sprintf(`
+
+	resource "ibm_is_network_acl_rules" "testacc_acl_rules" {
+		network_acl = ibm_is_network_acl.testacc_acl.id
+		%s
+		%s
+		rules {
+			name        = "deny-all"
+			action      = "deny"
+			direction   = "inbound"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+		}
+	}`, rules[first], rules[second])
+}
+
+func testAccCheckIBMISNetworkACLRulesShadowedConfig(vpcname, name string) string {
+	return testAccCheckIBMISNetworkACLRulesBaseConfig(vpcname, name) + `
+
+	resource "ibm_is_network_acl_rules" "testacc_acl_rules" {
+		network_acl = ibm_is_network_acl.testacc_acl.id
+
+		rules {
+			name        = "deny-all"
+			action      = "deny"
+			direction   = "inbound"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+		}
+		rules {
+			name        = "ssh"
+			action      = "allow"
+			direction   = "inbound"
+			source      = "10.0.0.0/8"
+			destination = "0.0.0.0/0"
+			tcp {
+				port_min = 22
+				port_max = 22
+			}
+		}
+	}`
+}
@@ -65,7 +65,7 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -95,6 +95,7 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				Computed:    true,
 				Description: "Resource group ID for the network ACL",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isNetworkACLTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -472,7 +473,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" {
+	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *nwacl.CRN, "", isUserTagType)
 		if err != nil {
@@ -669,7 +670,7 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 			return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) : %s\n%s", id, err, response)
 		}
 	}
-	if d.HasChange(isNetworkACLTags) {
+	if d.HasChange(isNetworkACLTags) || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isNetworkACLCRN).(string), "", isUserTagType)
 		if err != nil {
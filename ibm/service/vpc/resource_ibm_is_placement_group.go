@@ -50,7 +50,7 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 		Schema: map[string]*schema.Schema{
@@ -74,6 +74,7 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 				ForceNew:    true,
 				Description: "The unique identifier of the resource group to use. If unspecified, the account's [default resourcegroup](https://cloud.ibm.com/apidocs/resource-manager#introduction) is used.",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isPlacementGroupTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -193,7 +194,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for placement group to be available %s", err))
 	}
-	if _, ok := d.GetOk(isPlacementGroupTags); ok {
+	if _, ok := d.GetOk(isPlacementGroupTags); ok || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isPlacementGroupTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isUserTagType)
 		if err != nil {
@@ -309,7 +310,7 @@ func resourceIbmIsPlacementGroupUpdate(context context.Context, d *schema.Resour
 			return diag.FromErr(err)
 		}
 	}
-	if d.HasChange(isPlacementGroupTags) {
+	if d.HasChange(isPlacementGroupTags) || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange(isPlacementGroupTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
 		if err != nil {
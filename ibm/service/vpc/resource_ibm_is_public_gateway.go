@@ -54,7 +54,7 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -107,6 +107,7 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 				Description: "Public gateway zone info",
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isPublicGatewayTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -254,7 +255,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isUserTagType)
 		if err != nil {
@@ -382,7 +383,7 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		name = d.Get(isPublicGatewayName).(string)
 		hasChanged = true
 	}
-	if d.HasChange(isPublicGatewayTags) {
+	if d.HasChange(isPublicGatewayTags) || d.HasChange(flex.ResourceTagsAll) {
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
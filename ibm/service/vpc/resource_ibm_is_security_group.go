@@ -42,7 +42,7 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -72,6 +72,7 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				ForceNew:    true,
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isSecurityGroupTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -206,7 +207,7 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(*sg.ID)
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" {
+	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *sg.CRN, "", isUserTagType)
 		if err != nil {
@@ -371,7 +372,7 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 	name := ""
 	hasChanged := false
 
-	if d.HasChange(isSecurityGroupTags) {
+	if d.HasChange(isSecurityGroupTags) || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSecurityGroupCRN).(string), "", isUserTagType)
 		if err != nil {
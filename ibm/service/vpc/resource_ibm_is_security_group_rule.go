@@ -238,8 +238,11 @@ func resourceIBMISSecurityGroupRuleCreate(d *schema.ResourceData, meta interface
 		return err
 	}
 	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutCreate, isSecurityGroupRuleKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	options := &vpcv1.CreateSecurityGroupRuleOptions{
 		SecurityGroupID:            &parsed.secgrpID,
@@ -273,6 +276,8 @@ func resourceIBMISSecurityGroupRuleCreate(d *schema.ResourceData, meta interface
 			d.SetId(tfID)
 		}
 	}
+	// Read takes the lock for reading
+	unlock()
 	return resourceIBMISSecurityGroupRuleRead(d, meta)
 }
 
@@ -285,6 +290,11 @@ func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return err
 	}
+	unlock, err := conns.IbmLockManager.RLockOperation(d, meta, schema.TimeoutRead, "security_group_rule_key_"+secgrpID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	getSecurityGroupRuleOptions := &vpcv1.GetSecurityGroupRuleOptions{
 		SecurityGroupID: &secgrpID,
@@ -415,14 +425,19 @@ func resourceIBMISSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutUpdate, isSecurityGroupRuleKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	updateSecurityGroupRuleOptions := sgTemplate
 	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Updating Security Group Rule : %s\n%s", err, response)
 	}
+	// Read takes the lock for reading
+	unlock()
 	return resourceIBMISSecurityGroupRuleRead(d, meta)
 }
 
@@ -437,8 +452,11 @@ func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface
 	}
 
 	isSecurityGroupRuleKey := "security_group_rule_key_" + secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutDelete, isSecurityGroupRuleKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	getSecurityGroupRuleOptions := &vpcv1.GetSecurityGroupRuleOptions{
 		SecurityGroupID: &secgrpID,
@@ -0,0 +1,646 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isSecurityGroupRulesID = "id"
+	// The remote the API reports for the IPv4 rules created without one
+	isSecurityGroupRuleAnyRemote = "0.0.0.0/0"
+)
+
+func ResourceIBMISSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISSecurityGroupRulesCreate,
+		ReadContext:   resourceIBMISSecurityGroupRulesRead,
+		UpdateContext: resourceIBMISSecurityGroupRulesUpdate,
+		DeleteContext: resourceIBMISSecurityGroupRulesDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			isSecurityGroupID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Security group id",
+			},
+
+			isSecurityGroupRules: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         resourceIBMISSecurityGroupRulesHash,
+				Description: "The complete set of rules of the security group, the rules not listed are removed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isSecurityGroupRulesID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Rule id",
+						},
+
+						isSecurityGroupRuleDirection: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Direction of traffic to enforce, either inbound or outbound",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDirection),
+						},
+
+						isSecurityGroupRuleIPVersion: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "IP version: ipv4",
+							Default:      isSecurityGroupRuleIPVersionDefault,
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleIPVersion),
+						},
+
+						isSecurityGroupRuleRemote: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Security group id: an IP address, a CIDR block, or a single security group identifier",
+						},
+
+						isSecurityGroupRuleProtocolICMP: {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "protocol=icmp",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									isSecurityGroupRuleType: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleType),
+									},
+									isSecurityGroupRuleCode: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleCode),
+									},
+								},
+							},
+						},
+
+						isSecurityGroupRuleProtocolTCP: {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "protocol=tcp",
+							Elem:        resourceIBMISSecurityGroupRulesPortRange(),
+						},
+
+						isSecurityGroupRuleProtocolUDP: {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "protocol=udp",
+							Elem:        resourceIBMISSecurityGroupRulesPortRange(),
+						},
+
+						isSecurityGroupRuleProtocol: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Security Group Rule Protocol",
+						},
+					},
+				},
+			},
+
+			flex.RelatedCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The crn of the Security Group",
+			},
+		},
+	}
+}
+
+func resourceIBMISSecurityGroupRulesPortRange() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isSecurityGroupRulePortMin: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMin),
+			},
+			isSecurityGroupRulePortMax: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      65535,
+				ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
+			},
+		},
+	}
+}
+
+func resourceIBMISSecurityGroupRulesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupID := d.Get(isSecurityGroupID).(string)
+	if err := applyIBMISSecurityGroupRules(context, d, meta, groupID); err != nil {
+		return flex.DiagFromErr(err)
+	}
+	d.SetId(groupID)
+	return resourceIBMISSecurityGroupRulesRead(context, d, meta)
+}
+
+func resourceIBMISSecurityGroupRulesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+	unlock, err := conns.IbmLockManager.RLock(context, meta, "security_group_rule_key_"+id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &id,
+	}
+	group, response, err := sess.GetSecurityGroupWithContext(context, getSecurityGroupOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return flex.APIErrorDiag(err, response, "vpc", "GetSecurityGroup", "ibm_is_security_group_rules", id)
+	}
+
+	// The API reports the rules created without a remote as allowing any
+	// address, keep the remote as it was configured
+	known := map[string]securityGroupRuleSpec{}
+	for _, rule := range expandSecurityGroupRuleSpecs(d.Get(isSecurityGroupRules).(*schema.Set)) {
+		if rule.id != "" {
+			known[rule.id] = rule
+		}
+	}
+	rules := make([]interface{}, 0, len(group.Rules))
+	unmanaged := []string{}
+	for _, sgrule := range group.Rules {
+		rule, ok := securityGroupRuleSpecFromRule(sgrule)
+		if !ok {
+			continue
+		}
+		prior, isKnown := known[rule.id]
+		if rule.remote == isSecurityGroupRuleAnyRemote && (!isKnown || prior.remote == "") {
+			rule.remote = ""
+		}
+		if !isKnown && len(known) > 0 {
+			unmanaged = append(unmanaged, rule.id)
+		}
+		rules = append(rules, rule.toMap())
+	}
+	if len(unmanaged) > 0 {
+		log.Printf("[WARN] Security group %s has rules not managed by Terraform, they are removed on the next apply: %s", id, strings.Join(unmanaged, ", "))
+	}
+
+	d.Set(isSecurityGroupID, id)
+	if err = d.Set(isSecurityGroupRules, rules); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting rules: %s", err))
+	}
+	d.Set(flex.RelatedCRN, *group.CRN)
+	return nil
+}
+
+func resourceIBMISSecurityGroupRulesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isSecurityGroupRules) {
+		if err := applyIBMISSecurityGroupRules(context, d, meta, d.Id()); err != nil {
+			return flex.DiagFromErr(err)
+		}
+	}
+	return resourceIBMISSecurityGroupRulesRead(context, d, meta)
+}
+
+func resourceIBMISSecurityGroupRulesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.Set(isSecurityGroupRules, nil)
+	if err := applyIBMISSecurityGroupRules(context, d, meta, d.Id()); err != nil {
+		// The rules are gone with their security group
+		if flex.ErrorClassOf(err) == flex.ErrorClassNotFound {
+			log.Printf("[DEBUG] Security group %s is already deleted", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return flex.DiagFromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// applyIBMISSecurityGroupRules makes the rules of the group those of the
+// configuration with the fewest changes, in one batch holding the lock the
+// ibm_is_security_group_rule resources of the group use. The rules are set
+// with their IDs once applied.
+func applyIBMISSecurityGroupRules(context context.Context, d *schema.ResourceData, meta interface{}, groupID string) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	desired := expandSecurityGroupRuleSpecs(d.Get(isSecurityGroupRules).(*schema.Set))
+	for _, rule := range desired {
+		if rule.icmpCode != nil && rule.icmpType == nil {
+			return fmt.Errorf("[ERROR] Error in the %s rule of security group %s: icmp code requires icmp type", rule.direction, groupID)
+		}
+	}
+
+	isSecurityGroupRuleKey := "security_group_rule_key_" + groupID
+	unlock, err := conns.IbmLockManager.Lock(context, meta, isSecurityGroupRuleKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &groupID,
+	}
+	group, response, err := sess.GetSecurityGroupWithContext(context, getSecurityGroupOptions)
+	if err != nil {
+		return flex.NewAPIError(err, response, "vpc", "GetSecurityGroup", "ibm_is_security_group_rules", groupID)
+	}
+	current := make([]securityGroupRuleSpec, 0, len(group.Rules))
+	for _, sgrule := range group.Rules {
+		if rule, ok := securityGroupRuleSpecFromRule(sgrule); ok {
+			current = append(current, rule)
+		}
+	}
+
+	changes := diffSecurityGroupRules(current, desired)
+	log.Printf("[INFO] Changing the rules of security group %s: %d to remove, %d to modify, %d to add", groupID, len(changes.remove), len(changes.modify), len(changes.add))
+
+	// Remove first, so that the rules being replaced don't count against the quota
+	for _, rule := range changes.remove {
+		deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &groupID,
+			ID:              core.StringPtr(rule.id),
+		}
+		response, err := sess.DeleteSecurityGroupRuleWithContext(context, deleteSecurityGroupRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return flex.NewAPIError(err, response, "vpc", "DeleteSecurityGroupRule", "ibm_is_security_group_rules", groupID)
+		}
+	}
+	applied := make([]interface{}, 0, len(desired))
+	for _, change := range changes.keep {
+		change.to.id = change.from.id
+		applied = append(applied, change.to.toMap())
+	}
+	for _, change := range changes.modify {
+		patch, err := change.to.patch()
+		if err != nil {
+			return err
+		}
+		updateSecurityGroupRuleOptions := &vpcv1.UpdateSecurityGroupRuleOptions{
+			SecurityGroupID:        &groupID,
+			ID:                     core.StringPtr(change.from.id),
+			SecurityGroupRulePatch: patch,
+		}
+		_, response, err := sess.UpdateSecurityGroupRuleWithContext(context, updateSecurityGroupRuleOptions)
+		if err != nil {
+			return flex.NewAPIError(err, response, "vpc", "UpdateSecurityGroupRule", "ibm_is_security_group_rules", groupID)
+		}
+		change.to.id = change.from.id
+		applied = append(applied, change.to.toMap())
+	}
+	for _, rule := range changes.add {
+		options := &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID:            &groupID,
+			SecurityGroupRulePrototype: rule.prototype(),
+		}
+		sgrule, response, err := sess.CreateSecurityGroupRuleWithContext(context, options)
+		if err != nil {
+			return flex.NewAPIError(err, response, "vpc", "CreateSecurityGroupRule", "ibm_is_security_group_rules", groupID)
+		}
+		if created, ok := securityGroupRuleSpecFromRule(sgrule); ok {
+			rule.id = created.id
+		}
+		applied = append(applied, rule.toMap())
+	}
+	return d.Set(isSecurityGroupRules, applied)
+}
+
+// securityGroupRuleSpec is a security group rule independently of its protocol.
+// Unset ICMP type and code are nil, and TCP and UDP rules always have ports.
+type securityGroupRuleSpec struct {
+	id        string
+	direction string
+	ipVersion string
+	remote    string
+	protocol  string
+	icmpType  *int64
+	icmpCode  *int64
+	portMin   int64
+	portMax   int64
+}
+
+type securityGroupRuleChange struct {
+	from, to securityGroupRuleSpec
+}
+
+type securityGroupRuleChanges struct {
+	keep   []securityGroupRuleChange
+	remove []securityGroupRuleSpec
+	modify []securityGroupRuleChange
+	add    []securityGroupRuleSpec
+}
+
+// diffSecurityGroupRules keeps the current rules equal to a desired one, then
+// modifies the remaining rules into the remaining desired rules of the same
+// protocol, which the API can't change, preferring those of the same direction.
+func diffSecurityGroupRules(current, desired []securityGroupRuleSpec) securityGroupRuleChanges {
+	changes := securityGroupRuleChanges{}
+	byKey := map[string][]securityGroupRuleSpec{}
+	for _, rule := range current {
+		byKey[rule.key()] = append(byKey[rule.key()], rule)
+	}
+	matched := map[string]bool{}
+	var pending []securityGroupRuleSpec
+	for _, rule := range desired {
+		key := rule.key()
+		if same := byKey[key]; len(same) > 0 {
+			byKey[key] = same[1:]
+			matched[same[0].id] = true
+			changes.keep = append(changes.keep, securityGroupRuleChange{from: same[0], to: rule})
+			continue
+		}
+		pending = append(pending, rule)
+	}
+
+	var remaining []securityGroupRuleSpec
+	for _, rule := range current {
+		if !matched[rule.id] {
+			remaining = append(remaining, rule)
+		}
+	}
+	for _, rule := range pending {
+		match := -1
+		for i, candidate := range remaining {
+			if candidate.protocol != rule.protocol {
+				continue
+			}
+			if match == -1 || (candidate.direction == rule.direction && remaining[match].direction != rule.direction) {
+				match = i
+			}
+		}
+		if match == -1 {
+			changes.add = append(changes.add, rule)
+			continue
+		}
+		changes.modify = append(changes.modify, securityGroupRuleChange{from: remaining[match], to: rule})
+		remaining = append(remaining[:match], remaining[match+1:]...)
+	}
+	changes.remove = remaining
+	return changes
+}
+
+// key identifies the rule by everything but its ID, with the default remote
+// and IP version spelled as the API reports them.
+func (r securityGroupRuleSpec) key() string {
+	remote := r.remote
+	if remote == "" {
+		remote = isSecurityGroupRuleAnyRemote
+	}
+	parts := []string{r.direction, strings.ToLower(r.ipVersion), remote, r.protocol}
+	switch r.protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		parts = append(parts, optionalInt64String(r.icmpType), optionalInt64String(r.icmpCode))
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		parts = append(parts, fmt.Sprint(r.portMin), fmt.Sprint(r.portMax))
+	}
+	return strings.Join(parts, "|")
+}
+
+func (r securityGroupRuleSpec) prototype() *vpcv1.SecurityGroupRulePrototype {
+	prototype := &vpcv1.SecurityGroupRulePrototype{
+		Direction: &r.direction,
+		IPVersion: &r.ipVersion,
+		Protocol:  &r.protocol,
+	}
+	if r.remote != "" {
+		prototype.Remote = r.remotePrototype()
+	}
+	switch r.protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		prototype.Type = r.icmpType
+		prototype.Code = r.icmpCode
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		prototype.PortMin = &r.portMin
+		prototype.PortMax = &r.portMax
+	}
+	return prototype
+}
+
+func (r securityGroupRuleSpec) remotePrototype() *vpcv1.SecurityGroupRuleRemotePrototype {
+	address, cidr, id, _ := inferRemoteSecurityGroup(r.remote)
+	remote := &vpcv1.SecurityGroupRuleRemotePrototype{}
+	if address != "" {
+		remote.Address = &address
+	} else if cidr != "" {
+		remote.CIDRBlock = &cidr
+	} else {
+		remote.ID = &id
+	}
+	return remote
+}
+
+func (r securityGroupRuleSpec) patch() (map[string]interface{}, error) {
+	securityGroupRulePatchModel := &vpcv1.SecurityGroupRulePatch{
+		Direction: &r.direction,
+		IPVersion: &r.ipVersion,
+	}
+	remote := r.remote
+	if remote == "" {
+		remote = isSecurityGroupRuleAnyRemote
+	}
+	address, cidr, id, _ := inferRemoteSecurityGroup(remote)
+	remotePatch := &vpcv1.SecurityGroupRuleRemotePatch{}
+	if address != "" {
+		remotePatch.Address = &address
+	} else if cidr != "" {
+		remotePatch.CIDRBlock = &cidr
+	} else {
+		remotePatch.ID = &id
+	}
+	securityGroupRulePatchModel.Remote = remotePatch
+	switch r.protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		securityGroupRulePatchModel.Type = r.icmpType
+		securityGroupRulePatchModel.Code = r.icmpCode
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		securityGroupRulePatchModel.PortMin = &r.portMin
+		securityGroupRulePatchModel.PortMax = &r.portMax
+	}
+	securityGroupRulePatch, err := securityGroupRulePatchModel.AsPatch()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error calling asPatch for SecurityGroupRulePatch: %s", err)
+	}
+	if r.protocol == isSecurityGroupRuleProtocolICMP {
+		if r.icmpType == nil {
+			securityGroupRulePatch["type"] = nil
+		}
+		if r.icmpCode == nil {
+			securityGroupRulePatch["code"] = nil
+		}
+	}
+	return securityGroupRulePatch, nil
+}
+
+func (r securityGroupRuleSpec) toMap() map[string]interface{} {
+	m := map[string]interface{}{
+		isSecurityGroupRulesID:       r.id,
+		isSecurityGroupRuleDirection: r.direction,
+		isSecurityGroupRuleIPVersion: r.ipVersion,
+		isSecurityGroupRuleRemote:    r.remote,
+		isSecurityGroupRuleProtocol:  r.protocol,
+	}
+	switch r.protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		icmp := map[string]interface{}{}
+		if r.icmpType != nil {
+			icmp[isSecurityGroupRuleType] = int(*r.icmpType)
+		}
+		if r.icmpCode != nil {
+			icmp[isSecurityGroupRuleCode] = int(*r.icmpCode)
+		}
+		m[isSecurityGroupRuleProtocolICMP] = []interface{}{icmp}
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		m[r.protocol] = []interface{}{map[string]interface{}{
+			isSecurityGroupRulePortMin: int(r.portMin),
+			isSecurityGroupRulePortMax: int(r.portMax),
+		}}
+	}
+	return m
+}
+
+// expandSecurityGroupRuleSpecs returns the rules of the set, ordered by key so
+// that the changes are applied in the same order on every run.
+func expandSecurityGroupRuleSpecs(set *schema.Set) []securityGroupRuleSpec {
+	rules := make([]securityGroupRuleSpec, 0, set.Len())
+	for _, v := range set.List() {
+		rules = append(rules, expandSecurityGroupRuleSpec(v.(map[string]interface{})))
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].key() < rules[j].key()
+	})
+	return rules
+}
+
+// expandSecurityGroupRuleSpec reads a rule as the ibm_is_security_group_rule
+// resource does: zero ICMP types and codes are unset, and a TCP or UDP block
+// without ports or with one of them covers all ports or the single port.
+func expandSecurityGroupRuleSpec(m map[string]interface{}) securityGroupRuleSpec {
+	rule := securityGroupRuleSpec{
+		protocol:  "all",
+		ipVersion: isSecurityGroupRuleIPVersionDefault,
+	}
+	if v, ok := m[isSecurityGroupRulesID].(string); ok {
+		rule.id = v
+	}
+	if v, ok := m[isSecurityGroupRuleDirection].(string); ok {
+		rule.direction = v
+	}
+	if v, ok := m[isSecurityGroupRuleIPVersion].(string); ok && v != "" {
+		rule.ipVersion = v
+	}
+	if v, ok := m[isSecurityGroupRuleRemote].(string); ok {
+		rule.remote = v
+	}
+	if icmp, ok := m[isSecurityGroupRuleProtocolICMP].([]interface{}); ok && len(icmp) > 0 {
+		rule.protocol = isSecurityGroupRuleProtocolICMP
+		if icmp[0] != nil {
+			values := icmp[0].(map[string]interface{})
+			if v, ok := values[isSecurityGroupRuleType].(int); ok && v != 0 {
+				rule.icmpType = core.Int64Ptr(int64(v))
+			}
+			if v, ok := values[isSecurityGroupRuleCode].(int); ok && v != 0 {
+				rule.icmpCode = core.Int64Ptr(int64(v))
+			}
+		}
+	}
+	for _, protocol := range []string{isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+		ports, ok := m[protocol].([]interface{})
+		if !ok || len(ports) == 0 {
+			continue
+		}
+		rule.protocol = protocol
+		rule.portMin, rule.portMax = 1, 65535
+		if ports[0] != nil {
+			values := ports[0].(map[string]interface{})
+			portMin, haveMin := values[isSecurityGroupRulePortMin].(int)
+			portMax, haveMax := values[isSecurityGroupRulePortMax].(int)
+			haveMin = haveMin && portMin != 0
+			haveMax = haveMax && portMax != 0
+			if haveMin {
+				rule.portMin = int64(portMin)
+			}
+			if haveMax {
+				rule.portMax = int64(portMax)
+			}
+			if haveMin && !haveMax {
+				rule.portMax = rule.portMin
+			}
+			if haveMax && !haveMin {
+				rule.portMin = rule.portMax
+			}
+		}
+	}
+	return rule
+}
+
+func securityGroupRuleSpecFromRule(sgrule vpcv1.SecurityGroupRuleIntf) (securityGroupRuleSpec, bool) {
+	rule := securityGroupRuleSpec{}
+	var remote vpcv1.SecurityGroupRuleRemoteIntf
+	switch r := sgrule.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		rule.id, rule.direction, rule.ipVersion, rule.protocol = *r.ID, *r.Direction, *r.IPVersion, *r.Protocol
+		remote = r.Remote
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		rule.id, rule.direction, rule.ipVersion, rule.protocol = *r.ID, *r.Direction, *r.IPVersion, *r.Protocol
+		rule.icmpType, rule.icmpCode = r.Type, r.Code
+		remote = r.Remote
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		rule.id, rule.direction, rule.ipVersion, rule.protocol = *r.ID, *r.Direction, *r.IPVersion, *r.Protocol
+		if r.PortMin != nil {
+			rule.portMin = *r.PortMin
+		}
+		if r.PortMax != nil {
+			rule.portMax = *r.PortMax
+		}
+		remote = r.Remote
+	default:
+		return rule, false
+	}
+	if remote, ok := remote.(*vpcv1.SecurityGroupRuleRemote); ok && remote != nil {
+		if remote.ID != nil {
+			rule.remote = *remote.ID
+		} else if remote.Address != nil {
+			rule.remote = *remote.Address
+		} else if remote.CIDRBlock != nil {
+			rule.remote = *remote.CIDRBlock
+		}
+	}
+	return rule, true
+}
+
+func optionalInt64String(v *int64) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(*v)
+}
+
+func resourceIBMISSecurityGroupRulesHash(v interface{}) int {
+	return schema.HashString(expandSecurityGroupRuleSpec(v.(map[string]interface{})).key())
+}
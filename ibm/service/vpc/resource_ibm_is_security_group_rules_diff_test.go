@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestDiffSecurityGroupRules(t *testing.T) {
+	tcp := func(id, direction string, port int64) securityGroupRuleSpec {
+		return securityGroupRuleSpec{id: id, direction: direction, ipVersion: "ipv4", protocol: isSecurityGroupRuleProtocolTCP, portMin: port, portMax: port}
+	}
+	icmp := func(id, direction string) securityGroupRuleSpec {
+		return securityGroupRuleSpec{id: id, direction: direction, ipVersion: "ipv4", protocol: isSecurityGroupRuleProtocolICMP}
+	}
+	anyRemote := func(rule securityGroupRuleSpec) securityGroupRuleSpec {
+		rule.remote = isSecurityGroupRuleAnyRemote
+		return rule
+	}
+	// summary lists the IDs of the current rules kept, modified and removed,
+	// and the ports of the rules modified into and added
+	type summary struct {
+		keep, modify, remove, add []string
+	}
+	summarize := func(changes securityGroupRuleChanges) summary {
+		var s summary
+		for _, change := range changes.keep {
+			s.keep = append(s.keep, change.from.id)
+		}
+		for _, change := range changes.modify {
+			s.modify = append(s.modify, fmt.Sprintf("%s:%s/%d", change.from.id, change.to.direction, change.to.portMin))
+		}
+		for _, rule := range changes.remove {
+			s.remove = append(s.remove, rule.id)
+		}
+		for _, rule := range changes.add {
+			s.add = append(s.add, fmt.Sprintf("%s/%s/%d", rule.protocol, rule.direction, rule.portMin))
+		}
+		return s
+	}
+
+	cases := []struct {
+		name     string
+		current  []securityGroupRuleSpec
+		desired  []securityGroupRuleSpec
+		expected summary
+	}{
+		{
+			name:     "unchanged rules are kept",
+			current:  []securityGroupRuleSpec{tcp("a", "inbound", 22), icmp("b", "outbound")},
+			desired:  []securityGroupRuleSpec{icmp("", "outbound"), tcp("", "inbound", 22)},
+			expected: summary{keep: []string{"b", "a"}},
+		},
+		{
+			name:     "the any remote reported by the API matches an unset remote",
+			current:  []securityGroupRuleSpec{anyRemote(tcp("a", "inbound", 22))},
+			desired:  []securityGroupRuleSpec{tcp("", "inbound", 22)},
+			expected: summary{keep: []string{"a"}},
+		},
+		{
+			name:     "a port change modifies the rule",
+			current:  []securityGroupRuleSpec{tcp("a", "inbound", 22)},
+			desired:  []securityGroupRuleSpec{tcp("", "inbound", 443)},
+			expected: summary{modify: []string{"a:inbound/443"}},
+		},
+		{
+			name:     "a protocol change replaces the rule",
+			current:  []securityGroupRuleSpec{tcp("a", "inbound", 22)},
+			desired:  []securityGroupRuleSpec{icmp("", "inbound")},
+			expected: summary{remove: []string{"a"}, add: []string{"icmp/inbound/0"}},
+		},
+		{
+			name:     "a rule of the same direction is modified first",
+			current:  []securityGroupRuleSpec{tcp("a", "inbound", 22), tcp("b", "outbound", 80)},
+			desired:  []securityGroupRuleSpec{tcp("", "outbound", 443)},
+			expected: summary{modify: []string{"b:outbound/443"}, remove: []string{"a"}},
+		},
+		{
+			name:     "a rule of the other direction is modified when there is no other",
+			current:  []securityGroupRuleSpec{tcp("a", "inbound", 22)},
+			desired:  []securityGroupRuleSpec{tcp("", "outbound", 22)},
+			expected: summary{modify: []string{"a:outbound/22"}},
+		},
+		{
+			name:     "duplicates of a rule are removed",
+			current:  []securityGroupRuleSpec{tcp("a", "inbound", 22), tcp("b", "inbound", 22)},
+			desired:  []securityGroupRuleSpec{tcp("", "inbound", 22)},
+			expected: summary{keep: []string{"a"}, remove: []string{"b"}},
+		},
+		{
+			name:     "every rule is removed when none is desired",
+			current:  []securityGroupRuleSpec{tcp("a", "inbound", 22), icmp("b", "outbound")},
+			expected: summary{remove: []string{"a", "b"}},
+		},
+		{
+			name:     "every rule is added to an empty group",
+			desired:  []securityGroupRuleSpec{tcp("", "inbound", 22), tcp("", "inbound", 443)},
+			expected: summary{add: []string{"tcp/inbound/22", "tcp/inbound/443"}},
+		},
+	}
+	for _, c := range cases {
+		if s := summarize(diffSecurityGroupRules(c.current, c.desired)); !reflect.DeepEqual(s, c.expected) {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.expected, s)
+		}
+	}
+}
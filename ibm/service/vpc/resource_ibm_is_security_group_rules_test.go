@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISSecurityGroupRules_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfsgrules-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsgrules-sg-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name, 8080),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRulesCount("ibm_is_security_group_rules.testacc_security_group_rules", 4),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.#", "4"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name, 8443),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRulesCount("ibm_is_security_group_rules.testacc_security_group_rules", 4),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.*", map[string]string{
+							"direction":      "inbound",
+							"tcp.0.port_min": "8443",
+							"tcp.0.port_max": "8443",
+						}),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_security_group_rules.testacc_security_group_rules",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSecurityGroupRulesDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_security_group_rules" {
+			continue
+		}
+
+		listSecurityGroupRulesOptions := &vpcv1.ListSecurityGroupRulesOptions{
+			SecurityGroupID: &rs.Primary.ID,
+		}
+		rules, _, err := sess.ListSecurityGroupRules(listSecurityGroupRulesOptions)
+		if err == nil && len(rules.Rules) > 0 {
+			return fmt.Errorf("security group %s still has %d rules", rs.Primary.ID, len(rules.Rules))
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISSecurityGroupRulesCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		listSecurityGroupRulesOptions := &vpcv1.ListSecurityGroupRulesOptions{
+			SecurityGroupID: &rs.Primary.ID,
+		}
+		rules, _, err := sess.ListSecurityGroupRules(listSecurityGroupRulesOptions)
+		if err != nil {
+			return err
+		}
+		if len(rules.Rules) != count {
+			return fmt.Errorf("expected %d rules in security group %s, got %d", count, rs.Primary.ID, len(rules.Rules))
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name string, port int) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group_rules" "testacc_security_group_rules" {
+		group = ibm_is_security_group.testacc_security_group.id
+
+		rules {
+			direction = "outbound"
+		}
+		rules {
+			direction = "inbound"
+			remote    = "127.0.0.1"
+			icmp {
+				type = 8
+			}
+		}
+		rules {
+			direction = "inbound"
+			remote    = "10.0.0.0/8"
+			udp {
+				port_min = 805
+				port_max = 807
+			}
+		}
+		rules {
+			direction = "inbound"
+			tcp {
+				port_min = %d
+				port_max = %d
+			}
+		}
+	}`, vpcname, name, port, port)
+}
@@ -42,7 +42,7 @@ func ResourceIbmIsShare() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -617,6 +617,7 @@ func ResourceIbmIsShare() *schema.Resource {
 				ForceNew:    true,
 				Description: "The globally unique name of the zone this file share will reside in.",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isFileShareTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -815,6 +816,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 					replicaShare.UserTags = userTagsArray
 				}
 			}
+			replicaShare.UserTags = flex.WithDefaultTags(replicaShare.UserTags, meta)
 			sharePrototype.ReplicaShare = replicaShare
 		}
 	} else {
@@ -881,6 +883,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 			sharePrototype.UserTags = userTagsArray
 		}
 	}
+	sharePrototype.UserTags = flex.WithDefaultTags(sharePrototype.UserTags, meta)
 	createShareOptions.SetSharePrototype(sharePrototype)
 	share, response, err := vpcClient.CreateShareWithContext(context, createShareOptions)
 	if err != nil {
@@ -1123,7 +1126,7 @@ func resourceIbmIsShareRead(context context.Context, d *schema.ResourceData, met
 	d.Set(isFileShareAccessTags, accesstags)
 	// d.Set(isFileShareTags, tags)
 	if share.UserTags != nil {
-		if err = d.Set(isFileShareTags, share.UserTags); err != nil {
+		if err = d.Set(isFileShareTags, flex.WithoutDefaultTags(share.UserTags, meta)); err != nil {
 			log.Printf(
 				"Error setting shares (%s) user tags: %s", d.Id(), err)
 		}
@@ -1447,7 +1450,7 @@ func ShareReplicaToMap(context context.Context, vpcClient *vpcv1.VpcV1, d *schem
 	shareReplicaMap[isFileShareAccessTags] = accesstags
 	// d.Set(isFileShareTags, tags)
 	if shareReplica.UserTags != nil {
-		shareReplicaMap[isFileShareTags] = shareReplica.UserTags
+		shareReplicaMap[isFileShareTags] = flex.WithoutDefaultTags(shareReplica.UserTags, meta)
 	}
 
 	shareReplicaMap["mount_targets"] = targets
@@ -1561,12 +1564,12 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 		hasChange = true
 	}
 
-	if d.HasChange(shareTagsSchema) {
+	if d.HasChange(shareTagsSchema) || (shareType == "share" && d.HasChange(flex.ResourceTagsAll)) {
 		var userTags *schema.Set
-		if v, ok := d.GetOk(shareTagsSchema); ok {
+		if v, ok := d.GetOk(shareTagsSchema); ok || flex.HasDefaultTags(meta) {
 
 			userTags = v.(*schema.Set)
-			if userTags != nil && userTags.Len() != 0 {
+			if userTags != nil && (userTags.Len() != 0 || flex.HasDefaultTags(meta)) {
 				userTagsArray := make([]string, userTags.Len())
 				for i, userTag := range userTags.List() {
 					userTagStr := userTag.(string)
@@ -1579,7 +1582,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 					userTagsArray = append(userTagsArray, envTags...)
 				}
 
-				sharePatchModel.UserTags = userTagsArray
+				sharePatchModel.UserTags = flex.WithDefaultTags(userTagsArray, meta)
 			}
 		}
 		hasChange = true
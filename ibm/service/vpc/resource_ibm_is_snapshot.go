@@ -68,7 +68,7 @@ func ResourceIBMSnapshot() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -327,6 +327,7 @@ func ResourceIBMSnapshot() *schema.Resource {
 				Description: "Zones for creating the snapshot clone",
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isSnapshotUserTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -494,9 +495,9 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	var userTags *schema.Set
-	if v, ok := d.GetOk(isSnapshotUserTags); ok {
+	if v, ok := d.GetOk(isSnapshotUserTags); ok || flex.HasDefaultTags(meta) {
 		userTags = v.(*schema.Set)
-		if userTags != nil && userTags.Len() != 0 {
+		if userTags != nil && (userTags.Len() != 0 || flex.HasDefaultTags(meta)) {
 			userTagsArray := make([]string, userTags.Len())
 			for i, userTag := range userTags.List() {
 				userTagStr := userTag.(string)
@@ -508,6 +509,7 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 				envTags = strings.Split(schematicTags, ",")
 				userTagsArray = append(userTagsArray, envTags...)
 			}
+			userTagsArray = flex.WithDefaultTags(userTagsArray, meta)
 			if snapbyVolFlag {
 				snapshotprototypeoptions.UserTags = userTagsArray
 			} else {
@@ -623,7 +625,7 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 	d.Set(isSnapshotResourceType, *snapshot.ResourceType)
 	d.Set(isSnapshotBootable, *snapshot.Bootable)
 	if snapshot.UserTags != nil {
-		if err = d.Set(isSnapshotUserTags, snapshot.UserTags); err != nil {
+		if err = d.Set(isSnapshotUserTags, flex.WithoutDefaultTags(snapshot.UserTags, meta)); err != nil {
 			return fmt.Errorf("[ERROR] Error setting user tags: %s", err)
 		}
 	}
@@ -746,12 +748,12 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 	updateSnapshotOptions.IfMatch = &eTag
 
 	// user tags update
-	if d.HasChange(isSnapshotUserTags) {
+	if d.HasChange(isSnapshotUserTags) || d.HasChange(flex.ResourceTagsAll) {
 		var userTags *schema.Set
-		if v, ok := d.GetOk(isSnapshotUserTags); ok {
+		if v, ok := d.GetOk(isSnapshotUserTags); ok || flex.HasDefaultTags(meta) {
 
 			userTags = v.(*schema.Set)
-			if userTags != nil && userTags.Len() != 0 {
+			if userTags != nil && (userTags.Len() != 0 || flex.HasDefaultTags(meta)) {
 				userTagsArray := make([]string, userTags.Len())
 				for i, userTag := range userTags.List() {
 					userTagStr := userTag.(string)
@@ -764,7 +766,7 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 					userTagsArray = append(userTagsArray, envTags...)
 				}
 				snapshotPatchModel := &vpcv1.SnapshotPatch{}
-				snapshotPatchModel.UserTags = userTagsArray
+				snapshotPatchModel.UserTags = flex.WithDefaultTags(userTagsArray, meta)
 				snapshotPatch, err := snapshotPatchModel.AsPatch()
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error calling asPatch for SnapshotPatch: %s", err)
@@ -47,7 +47,7 @@ func ResourceIBMISSSHKey() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -92,6 +92,7 @@ func ResourceIBMISSSHKey() *schema.Resource {
 				Computed:    true,
 				Description: "SSH key Length",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isKeyTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -230,7 +231,7 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 	log.Printf("[INFO] Key : %s", *key.ID)
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isKeyTags); ok || v != "" {
+	if _, ok := d.GetOk(isKeyTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isKeyTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyUserTagType)
 		if err != nil {
@@ -332,7 +333,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isKeyTags) {
+	if d.HasChange(isKeyTags) || d.HasChange(flex.ResourceTagsAll) {
 		options := &vpcv1.GetKeyOptions{
 			ID: &id,
 		}
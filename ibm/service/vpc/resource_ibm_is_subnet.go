@@ -50,12 +50,12 @@ const (
 
 func ResourceIBMISSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSubnetCreate,
-		Read:     resourceIBMISSubnetRead,
-		Update:   resourceIBMISSubnetUpdate,
-		Delete:   resourceIBMISSubnetDelete,
-		Exists:   resourceIBMISSubnetExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: flex.APIErrorContextFunc(resourceIBMISSubnetCreate),
+		ReadContext:   flex.APIErrorContextFunc(resourceIBMISSubnetRead),
+		UpdateContext: flex.APIErrorContextFunc(resourceIBMISSubnetUpdate),
+		DeleteContext: flex.APIErrorContextFunc(resourceIBMISSubnetDelete),
+		Exists:        resourceIBMISSubnetExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -65,12 +65,13 @@ func ResourceIBMISSubnet() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			validate.InvokeConstraintValidator("ibm_is_subnet"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -115,6 +116,7 @@ func ResourceIBMISSubnet() *schema.Resource {
 				Description:  "Subnet name",
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isSubnetTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -262,11 +264,18 @@ func ResourceIBMISSubnetValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
-	ibmISSubnetResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_subnet", Schema: validateSchema}
+	constraints := []validate.ValidateConstraint{
+		{
+			ConstraintIdentifier: validate.ExactlyOneOf,
+			Identifiers:          []string{isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount},
+		},
+	}
+
+	ibmISSubnetResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_subnet", Schema: validateSchema, Constraints: constraints}
 	return &ibmISSubnetResourceValidator
 }
 
-func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	name := d.Get(isSubnetName).(string)
 	vpc := d.Get(isSubnetVPC).(string)
@@ -282,16 +291,12 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		ipv4addrcount = ipv4addrct.(int)
 		ipv4addrcount64 = int64(ipv4addrcount)
 	}
-	if ipv4cidr == "" && ipv4addrcount == 0 {
-		return fmt.Errorf("%s or %s need to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount)
-	}
-
-	if ipv4cidr != "" && ipv4addrcount != 0 {
-		return fmt.Errorf("only one of %s or %s needs to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount)
-	}
 	isSubnetKey := "subnet_key_" + vpc + "_" + zone
-	conns.IbmMutexKV.Lock(isSubnetKey)
-	defer conns.IbmMutexKV.Unlock(isSubnetKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutCreate, isSubnetKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	acl := ""
 	if nwacl, ok := d.GetOk(isSubnetNetworkACL); ok {
@@ -309,15 +314,15 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		rtID = rt.(string)
 	}
 
-	err := subnetCreate(d, meta, name, vpc, zone, ipv4cidr, acl, gw, rtID, ipv4addrcount64)
+	err = subnetCreate(ctx, d, meta, name, vpc, zone, ipv4cidr, acl, gw, rtID, ipv4addrcount64)
 	if err != nil {
 		return err
 	}
 
-	return resourceIBMISSubnetRead(d, meta)
+	return resourceIBMISSubnetRead(ctx, d, meta)
 }
 
-func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw, rtID string, ipv4addrcount64 int64) error {
+func subnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw, rtID string, ipv4addrcount64 int64) error {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -366,19 +371,19 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	createSubnetOptions := &vpcv1.CreateSubnetOptions{
 		SubnetPrototype: subnetTemplate,
 	}
-	subnet, response, err := sess.CreateSubnet(createSubnetOptions)
+	subnet, response, err := sess.CreateSubnetWithContext(ctx, createSubnetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Subnet err %s\n%s", err, response)
-		return fmt.Errorf("[ERROR] Error while creating Subnet %s\n%v", err, response)
+		return flex.NewAPIError(err, response, "vpc", "CreateSubnet", "ibm_is_subnet", "")
 	}
 	d.SetId(*subnet.ID)
 	log.Printf("[INFO] Subnet : %s", *subnet.ID)
-	_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSubnetTags); ok || v != "" {
+	if _, ok := d.GetOk(isSubnetTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isSubnetTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *subnet.CRN, "", isUserTagType)
 		if err != nil {
@@ -399,29 +404,29 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	return nil
 }
 
-func isWaitForSubnetAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetAvailable(ctx context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isSubnetProvisioning},
 		Target:     []string{isSubnetProvisioningDone, ""},
-		Refresh:    isSubnetRefreshFunc(subnetC, id),
+		Refresh:    isSubnetRefreshFunc(ctx, subnetC, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSubnetRefreshFunc(ctx context.Context, subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getSubnetOptions := &vpcv1.GetSubnetOptions{
 			ID: &id,
 		}
-		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
+		subnet, response, err := subnetC.GetSubnetWithContext(ctx, getSubnetOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Subnet : %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response, "vpc", "GetSubnet", "ibm_is_subnet", id)
 		}
 
 		if *subnet.Status == "available" || *subnet.Status == "failed" {
@@ -432,18 +437,18 @@ func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshF
 	}
 }
 
-func resourceIBMISSubnetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	id := d.Id()
 
-	err := subnetGet(d, meta, id)
+	err := subnetGet(ctx, d, meta, id)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
+func subnetGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -451,13 +456,13 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getSubnetOptions := &vpcv1.GetSubnetOptions{
 		ID: &id,
 	}
-	subnet, response, err := sess.GetSubnet(getSubnetOptions)
+	subnet, response, err := sess.GetSubnetWithContext(ctx, getSubnetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response, "vpc", "GetSubnet", "ibm_is_subnet", id)
 	}
 	d.Set(isSubnetName, *subnet.Name)
 	d.Set(isSubnetIPVersion, *subnet.IPVersion)
@@ -512,10 +517,10 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 
-	if d.HasChange(isSubnetTags) {
+	if d.HasChange(isSubnetTags) || d.HasChange(flex.ResourceTagsAll) {
 		oldList, newList := d.GetChange(isSubnetTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isUserTagType)
 		if err != nil {
@@ -533,15 +538,15 @@ func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	err := subnetUpdate(d, meta, id)
+	err := subnetUpdate(ctx, d, meta, id)
 	if err != nil {
 		return err
 	}
 
-	return resourceIBMISSubnetRead(d, meta)
+	return resourceIBMISSubnetRead(ctx, d, meta)
 }
 
-func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
+func subnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -569,11 +574,11 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			unsetSubnetPublicGatewayOptions := &vpcv1.UnsetSubnetPublicGatewayOptions{
 				ID: &id,
 			}
-			response, err := sess.UnsetSubnetPublicGateway(unsetSubnetPublicGatewayOptions)
+			response, err := sess.UnsetSubnetPublicGatewayWithContext(ctx, unsetSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Detaching the public gateway attached to the subnet : %s\n%s", err, response)
+				return flex.NewAPIError(err, response, "vpc", "UnsetSubnetPublicGateway", "ibm_is_subnet", id)
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -584,11 +589,11 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 					ID: &gw,
 				},
 			}
-			_, response, err := sess.SetSubnetPublicGateway(setSubnetPublicGatewayOptions)
+			_, response, err := sess.SetSubnetPublicGatewayWithContext(ctx, setSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Attaching public gateway to the subnet : %s\n%s", err, response)
+				return flex.NewAPIError(err, response, "vpc", "SetSubnetPublicGateway", "ibm_is_subnet", id)
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -607,7 +612,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		setSubnetRoutingTableBindingOptions := sess.NewReplaceSubnetRoutingTableOptions(id, rt)
 		setSubnetRoutingTableBindingOptions.SetRoutingTableIdentity(rt)
 		setSubnetRoutingTableBindingOptions.SetID(id)
-		_, _, err = sess.ReplaceSubnetRoutingTable(setSubnetRoutingTableBindingOptions)
+		_, _, err = sess.ReplaceSubnetRoutingTableWithContext(ctx, setSubnetRoutingTableBindingOptions)
 		if err != nil {
 			log.Printf("SetSubnetRoutingTableBinding eroor: %s", err)
 			return err
@@ -620,18 +625,18 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		updateSubnetOptions.SubnetPatch = subnetPatch
 		updateSubnetOptions.ID = &id
-		_, response, err := sess.UpdateSubnet(updateSubnetOptions)
+		_, response, err := sess.UpdateSubnetWithContext(ctx, updateSubnetOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Subnet : %s\n%s", err, response)
+			return flex.NewAPIError(err, response, "vpc", "UpdateSubnet", "ibm_is_subnet", id)
 		}
 	}
 	return nil
 }
 
-func resourceIBMISSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	id := d.Id()
-	err := subnetDelete(d, meta, id)
+	err := subnetDelete(ctx, d, meta, id)
 	if err != nil {
 		return err
 	}
@@ -640,7 +645,7 @@ func resourceIBMISSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func subnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -648,22 +653,22 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getSubnetOptions := &vpcv1.GetSubnetOptions{
 		ID: &id,
 	}
-	subnet, response, err := sess.GetSubnet(getSubnetOptions)
+	subnet, response, err := sess.GetSubnetWithContext(ctx, getSubnetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response, "vpc", "GetSubnet", "ibm_is_subnet", id)
 	}
 	if subnet.PublicGateway != nil {
 		unsetSubnetPublicGatewayOptions := &vpcv1.UnsetSubnetPublicGatewayOptions{
 			ID: &id,
 		}
-		_, err = sess.UnsetSubnetPublicGateway(unsetSubnetPublicGatewayOptions)
+		_, err = sess.UnsetSubnetPublicGatewayWithContext(ctx, unsetSubnetPublicGatewayOptions)
 		if err != nil {
 			return err
 		}
-		_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -671,19 +676,19 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteSubnetOptions := &vpcv1.DeleteSubnetOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteSubnet(deleteSubnetOptions)
+	response, err = sess.DeleteSubnetWithContext(ctx, deleteSubnetOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 409 {
 			log.Printf("[DEBUG] Delete subnet response status code: 409 conflict, provider will try again. %s", err)
-			_, err = isWaitForSubnetDeleteRetry(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+			_, err = isWaitForSubnetDeleteRetry(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Deleting Subnet : %s", err)
+				return fmt.Errorf("[ERROR] Error Deleting Subnet : %w", err)
 			}
 		} else {
-			return fmt.Errorf("[ERROR] Error Deleting Subnet : %s\n%s", err, response)
+			return flex.NewAPIError(err, response, "vpc", "DeleteSubnet", "ibm_is_subnet", id)
 		}
 	}
-	_, err = isWaitForSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSubnetDeleted(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -691,7 +696,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("[DEBUG] Retrying subnet (%s) delete", id)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isSubnetInUse},
@@ -701,14 +706,14 @@ func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.
 				ID: &id,
 			}
 			log.Printf("[DEBUG] Retrying subnet (%s) delete", id)
-			response, err := vpcClient.DeleteSubnet(deleteSubnetOptions)
+			response, err := vpcClient.DeleteSubnetWithContext(ctx, deleteSubnetOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 409 {
 					return response, isSubnetInUse, nil
 				} else if response != nil && response.StatusCode == 404 {
 					return response, isSubnetDeleted, nil
 				}
-				return response, "", fmt.Errorf("[ERROR] Error deleting subnet: %s\n%s", err, response)
+				return response, "", flex.NewAPIError(err, response, "vpc", "DeleteSubnet", "ibm_is_subnet", id)
 			}
 			return response, isSubnetDeleting, nil
 		},
@@ -716,31 +721,31 @@ func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isWaitForSubnetDeleted(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetDeleted(ctx context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isSubnetDeleting},
 		Target:     []string{isSubnetDeleted, ""},
-		Refresh:    isSubnetDeleteRefreshFunc(subnetC, id),
+		Refresh:    isSubnetDeleteRefreshFunc(ctx, subnetC, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSubnetDeleteRefreshFunc(ctx context.Context, subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is subnet delete function here")
 		getSubnetOptions := &vpcv1.GetSubnetOptions{
 			ID: &id,
 		}
-		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
+		subnet, response, err := subnetC.GetSubnetWithContext(ctx, getSubnetOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return subnet, isSubnetDeleted, nil
@@ -748,7 +753,7 @@ func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRe
 			if response != nil && strings.Contains(err.Error(), "please detach all network interfaces from subnet before deleting it") {
 				return subnet, isSubnetDeleting, nil
 			}
-			return subnet, "", fmt.Errorf("[ERROR] The Subnet %s failed to delete: %s\n%s", id, err, response)
+			return subnet, "", flex.NewAPIError(err, response, "vpc", "GetSubnet", "ibm_is_subnet", id)
 		}
 		return subnet, isSubnetDeleting, err
 	}
@@ -773,7 +778,7 @@ func subnetExists(d *schema.ResourceData, meta interface{}, id string) (bool, er
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Subnet: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response, "vpc", "GetSubnet", "ibm_is_subnet", id)
 	}
 	return true, nil
 }
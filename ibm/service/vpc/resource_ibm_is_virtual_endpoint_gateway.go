@@ -59,7 +59,7 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -217,6 +217,7 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 				Computed:    true,
 				Description: "Indicates whether to allow this endpoint gateway to participate in DNS resolution bindings with a VPC that has dns.enable_hub set to true.",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isVirtualEndpointGatewayTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -359,7 +360,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 		return err
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVirtualEndpointGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(isVirtualEndpointGatewayTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isUserTagType)
 		if err != nil {
@@ -457,7 +458,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error getting VPE: %s\n%s", err, response)
 		}
-		if d.HasChange(isVirtualEndpointGatewayTags) {
+		if d.HasChange(isVirtualEndpointGatewayTags) || d.HasChange(flex.ResourceTagsAll) {
 			oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isUserTagType)
 			if err != nil {
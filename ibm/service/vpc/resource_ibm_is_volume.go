@@ -69,7 +69,7 @@ func ResourceIBMISVolume() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -80,6 +80,7 @@ func ResourceIBMISVolume() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			validate.InvokeConstraintValidator("ibm_is_volume"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -220,6 +221,7 @@ func ResourceIBMISVolume() *schema.Resource {
 				Optional:    true,
 				Description: "Deletes all snapshots created from this volume",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isVolumeTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -390,10 +392,40 @@ func ResourceIBMISVolumeValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
-	ibmISVolumeResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_volume", Schema: validateSchema}
+	ibmISVolumeResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_volume", Schema: validateSchema, Constraints: volumeProfileConstraints()}
 	return &ibmISVolumeResourceValidator
 }
 
+// volumeProfileConstraints returns the limits the volume profiles put on the
+// capacity and iops of a volume, shared with ibm_is_instance_volume_attachment.
+// The iops of custom volumes depend on their capacity and are still checked
+// by flex.ResourceVolumeValidate.
+func volumeProfileConstraints() []validate.ValidateConstraint {
+	return []validate.ValidateConstraint{
+		{
+			ConstraintIdentifier: validate.IntBetweenIf,
+			Identifiers:          []string{isVolumeCapacity},
+			Condition:            isVolumeProfileName,
+			ConditionValues:      "5iops-tier",
+			MaxValue:             "9600",
+		},
+		{
+			ConstraintIdentifier: validate.IntBetweenIf,
+			Identifiers:          []string{isVolumeCapacity},
+			Condition:            isVolumeProfileName,
+			ConditionValues:      "10iops-tier",
+			MaxValue:             "4800",
+		},
+		// Only custom volumes have a configurable iops
+		{
+			ConstraintIdentifier: validate.ForbiddenIf,
+			Identifiers:          []string{isVolumeIops},
+			Condition:            isVolumeProfileName,
+			ConditionValues:      "general-purpose, 5iops-tier, 10iops-tier",
+		},
+	}
+}
+
 func resourceIBMISVolumeCreate(d *schema.ResourceData, meta interface{}) error {
 
 	volName := d.Get(isVolumeName).(string)
@@ -502,6 +534,7 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 			volTemplate.UserTags = userTagsArray
 		}
 	}
+	volTemplate.UserTags = flex.WithDefaultTags(volTemplate.UserTags, meta)
 
 	vol, response, err := sess.CreateVolume(options)
 	if err != nil {
@@ -589,7 +622,7 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 		d.Set(isVolumeStatusReasons, statusReasonsList)
 	}
 	if vol.UserTags != nil {
-		if err = d.Set(isVolumeTags, vol.UserTags); err != nil {
+		if err = d.Set(isVolumeTags, flex.WithoutDefaultTags(vol.UserTags, meta)); err != nil {
 			return fmt.Errorf("Error setting user tags: %s", err)
 		}
 	}
@@ -842,11 +875,11 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 	}
 
 	// user tags update
-	if d.HasChange(isVolumeTags) {
+	if d.HasChange(isVolumeTags) || d.HasChange(flex.ResourceTagsAll) {
 		var userTags *schema.Set
-		if v, ok := d.GetOk(isVolumeTags); ok {
+		if v, ok := d.GetOk(isVolumeTags); ok || flex.HasDefaultTags(meta) {
 			userTags = v.(*schema.Set)
-			if userTags != nil && userTags.Len() != 0 {
+			if userTags != nil && (userTags.Len() != 0 || flex.HasDefaultTags(meta)) {
 				userTagsArray := make([]string, userTags.Len())
 				for i, userTag := range userTags.List() {
 					userTagStr := userTag.(string)
@@ -859,7 +892,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 					userTagsArray = append(userTagsArray, envTags...)
 				}
 				volumeNamePatchModel := &vpcv1.VolumePatch{}
-				volumeNamePatchModel.UserTags = userTagsArray
+				volumeNamePatchModel.UserTags = flex.WithDefaultTags(userTagsArray, meta)
 				volumeNamePatch, err := volumeNamePatchModel.AsPatch()
 				if err != nil {
 					return fmt.Errorf("Error calling asPatch for volumeNamePatch: %s", err)
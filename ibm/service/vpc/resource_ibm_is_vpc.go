@@ -91,12 +91,12 @@ const (
 
 func ResourceIBMISVPC() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPCCreate,
-		Read:     resourceIBMISVPCRead,
-		Update:   resourceIBMISVPCUpdate,
-		Delete:   resourceIBMISVPCDelete,
-		Exists:   resourceIBMISVPCExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: flex.APIErrorContextFunc(resourceIBMISVPCCreate),
+		ReadContext:   flex.APIErrorContextFunc(resourceIBMISVPCRead),
+		UpdateContext: flex.APIErrorContextFunc(resourceIBMISVPCUpdate),
+		DeleteContext: flex.APIErrorContextFunc(resourceIBMISVPCDelete),
+		Exists:        resourceIBMISVPCExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -106,7 +106,7 @@ func ResourceIBMISVPC() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -363,6 +363,7 @@ func ResourceIBMISVPC() *schema.Resource {
 				Computed:    true,
 				Description: "Security group associated with VPC",
 			},
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isVPCTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -640,7 +641,7 @@ func ResourceIBMISVPCValidator() *validate.ResourceValidator {
 	return &ibmISVPCResourceValidator
 }
 
-func resourceIBMISVPCCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] VPC create")
 	name := d.Get(isVPCName).(string)
@@ -658,14 +659,14 @@ func resourceIBMISVPCCreate(d *schema.ResourceData, meta interface{}) error {
 	if grp, ok := d.GetOk(isVPCResourceGroup); ok {
 		rg = grp.(string)
 	}
-	err := vpcCreate(d, meta, name, apm, rg, isClassic)
+	err := vpcCreate(ctx, d, meta, name, apm, rg, isClassic)
 	if err != nil {
 		return err
 	}
-	return resourceIBMISVPCRead(d, meta)
+	return resourceIBMISVPCRead(ctx, d, meta)
 }
 
-func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, isClassic bool) error {
+func vpcCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, apm, rg string, isClassic bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -690,26 +691,26 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 	}
 	options.ClassicAccess = &isClassic
 
-	vpc, response, err := sess.CreateVPC(options)
+	vpc, response, err := sess.CreateVPCWithContext(ctx, options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating VPC %s ", flex.BeautifyError(err, response))
+		return flex.NewAPIError(err, response, "vpc", "CreateVPC", "ibm_is_vpc", "")
 	}
 	d.SetId(*vpc.ID)
 
 	if defaultSGName, ok := d.GetOk(isVPCDefaultSecurityGroupName); ok {
-		sgNameUpdate(sess, *vpc.DefaultSecurityGroup.ID, defaultSGName.(string))
+		sgNameUpdate(ctx, sess, *vpc.DefaultSecurityGroup.ID, defaultSGName.(string))
 	}
 
 	if defaultRTName, ok := d.GetOk(isVPCDefaultRoutingTableName); ok {
-		rtNameUpdate(sess, *vpc.ID, *vpc.DefaultRoutingTable.ID, defaultRTName.(string))
+		rtNameUpdate(ctx, sess, *vpc.ID, *vpc.DefaultRoutingTable.ID, defaultRTName.(string))
 	}
 
 	if defaultACLName, ok := d.GetOk(isVPCDefaultNetworkACLName); ok {
-		nwaclNameUpdate(sess, *vpc.DefaultNetworkACL.ID, defaultACLName.(string))
+		nwaclNameUpdate(ctx, sess, *vpc.DefaultNetworkACL.ID, defaultACLName.(string))
 	}
 
 	log.Printf("[INFO] VPC : %s", *vpc.ID)
-	_, err = isWaitForVPCAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVPCAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -717,12 +718,12 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 	if sgAclRules, ok := d.GetOk(isVPCNoSgAclRules); ok {
 		sgAclRules := sgAclRules.(bool)
 		if sgAclRules {
-			deleteDefaultNetworkACLRules(sess, *vpc.ID)
-			deleteDefaultSecurityGroupRules(sess, *vpc.ID)
+			deleteDefaultNetworkACLRules(ctx, sess, *vpc.ID)
+			deleteDefaultSecurityGroupRules(ctx, sess, *vpc.ID)
 		}
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPCTags); ok || v != "" {
+	if _, ok := d.GetOk(isVPCTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCUserTagType)
 		if err != nil {
@@ -741,24 +742,24 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 	return nil
 }
 
-func isWaitForVPCAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isVPCPending},
 		Target:     []string{isVPCAvailable, isVPCFailed},
-		Refresh:    isVPCRefreshFunc(vpc, id),
+		Refresh:    isVPCRefreshFunc(ctx, vpc, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func deleteDefaultNetworkACLRules(sess *vpcv1.VpcV1, vpcID string) error {
+func deleteDefaultNetworkACLRules(ctx context.Context, sess *vpcv1.VpcV1, vpcID string) error {
 	getVPCDefaultNetworkACLOptions := sess.NewGetVPCDefaultNetworkACLOptions(vpcID)
-	result, detail, err := sess.GetVPCDefaultNetworkACL(getVPCDefaultNetworkACLOptions)
+	result, detail, err := sess.GetVPCDefaultNetworkACLWithContext(ctx, getVPCDefaultNetworkACLOptions)
 	if err != nil || result == nil {
 		log.Printf("Error reading details of VPC Default Network ACL:%s", detail)
 		return err
@@ -772,19 +773,19 @@ func deleteDefaultNetworkACLRules(sess *vpcv1.VpcV1, vpcID string) error {
 					NetworkACLID: result.ID,
 					ID:           sourceRuleVal.ID,
 				}
-				_, response, err := sess.GetNetworkACLRule(getNetworkAclRuleOptions)
+				_, response, err := sess.GetNetworkACLRuleWithContext(ctx, getNetworkAclRuleOptions)
 
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Getting Network ACL Rule  (%s): %s\n%s", *sourceRuleVal.ID, err, response)
+					return flex.NewAPIError(err, response, "vpc", "GetNetworkACLRule", "ibm_is_vpc", vpcID)
 				}
 
 				deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
 					NetworkACLID: result.ID,
 					ID:           sourceRuleVal.ID,
 				}
-				response, err = sess.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
+				response, err = sess.DeleteNetworkACLRuleWithContext(ctx, deleteNetworkAclRuleOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Deleting Network ACL Rule : %s\n%s", err, response)
+					return flex.NewAPIError(err, response, "vpc", "DeleteNetworkACLRule", "ibm_is_vpc", vpcID)
 				}
 			}
 		}
@@ -792,9 +793,9 @@ func deleteDefaultNetworkACLRules(sess *vpcv1.VpcV1, vpcID string) error {
 	return nil
 }
 
-func deleteDefaultSecurityGroupRules(sess *vpcv1.VpcV1, vpcID string) error {
+func deleteDefaultSecurityGroupRules(ctx context.Context, sess *vpcv1.VpcV1, vpcID string) error {
 	getVPCDefaultSecurityGroupOptions := sess.NewGetVPCDefaultSecurityGroupOptions(vpcID)
-	result, detail, err := sess.GetVPCDefaultSecurityGroup(getVPCDefaultSecurityGroupOptions)
+	result, detail, err := sess.GetVPCDefaultSecurityGroupWithContext(ctx, getVPCDefaultSecurityGroupOptions)
 	if err != nil || result == nil {
 		log.Printf("Error reading details of VPC Default Security Group:%s", detail)
 		return err
@@ -808,19 +809,19 @@ func deleteDefaultSecurityGroupRules(sess *vpcv1.VpcV1, vpcID string) error {
 					SecurityGroupID: result.ID,
 					ID:              sourceRuleVal.ID,
 				}
-				_, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
+				_, response, err := sess.GetSecurityGroupRuleWithContext(ctx, getSecurityGroupRuleOptions)
 
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Getting Security Group Rule  (%s): %s\n%s", *sourceRuleVal.ID, err, response)
+					return flex.NewAPIError(err, response, "vpc", "GetSecurityGroupRule", "ibm_is_vpc", vpcID)
 				}
 
 				deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
 					SecurityGroupID: result.ID,
 					ID:              sourceRuleVal.ID,
 				}
-				response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+				response, err = sess.DeleteSecurityGroupRuleWithContext(ctx, deleteSecurityGroupRuleOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Deleting Security Group Rule : %s\n%s", err, response)
+					return flex.NewAPIError(err, response, "vpc", "DeleteSecurityGroupRule", "ibm_is_vpc", vpcID)
 				}
 			}
 		}
@@ -828,14 +829,14 @@ func deleteDefaultSecurityGroupRules(sess *vpcv1.VpcV1, vpcID string) error {
 	return nil
 }
 
-func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVPCRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
-		vpc, response, err := vpc.GetVPC(getvpcOptions)
+		vpc, response, err := vpc.GetVPCWithContext(ctx, getvpcOptions)
 		if err != nil {
-			return nil, isVPCFailed, fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+			return nil, isVPCFailed, flex.NewAPIError(err, response, "vpc", "GetVPC", "ibm_is_vpc", id)
 		}
 
 		if *vpc.Status == isVPCAvailable || *vpc.Status == isVPCFailed {
@@ -846,16 +847,16 @@ func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	}
 }
 
-func resourceIBMISVPCRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
-	err := vpcGet(d, meta, id)
+	err := vpcGet(ctx, d, meta, id)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
+func vpcGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -863,13 +864,13 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getvpcOptions := &vpcv1.GetVPCOptions{
 		ID: &id,
 	}
-	vpc, response, err := sess.GetVPC(getvpcOptions)
+	vpc, response, err := sess.GetVPCWithContext(ctx, getvpcOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+		return flex.NewAPIError(err, response, "vpc", "GetVPC", "ibm_is_vpc", id)
 	}
 
 	d.Set(isVPCName, *vpc.Name)
@@ -971,9 +972,9 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		if start != "" {
 			options.Start = &start
 		}
-		s, response, err := sess.ListSubnets(options)
+		s, response, err := sess.ListSubnetsWithContext(ctx, options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
+			return flex.NewAPIError(err, response, "vpc", "ListSubnets", "ibm_is_vpc", id)
 		}
 		start = flex.GetNext(s.Next)
 		allrecs = append(allrecs, s.Subnets...)
@@ -1002,7 +1003,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 	listSgOptions := &vpcv1.ListSecurityGroupsOptions{
 		VPCID: &vpcid,
 	}
-	sgs, _, err := sess.ListSecurityGroups(listSgOptions)
+	sgs, _, err := sess.ListSecurityGroupsWithContext(ctx, listSgOptions)
 	if err != nil {
 		return err
 	}
@@ -1119,7 +1120,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISVPCUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 
 	name := ""
@@ -1129,26 +1130,26 @@ func resourceIBMISVPCUpdate(d *schema.ResourceData, meta interface{}) error {
 		name = d.Get(isVPCName).(string)
 		hasChanged = true
 	}
-	err := vpcUpdate(d, meta, id, name, hasChanged)
+	err := vpcUpdate(ctx, d, meta, id, name, hasChanged)
 	if err != nil {
 		return err
 	}
-	return resourceIBMISVPCRead(d, meta)
+	return resourceIBMISVPCRead(ctx, d, meta)
 }
 
-func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
+func vpcUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
 	}
 
-	if d.HasChange(isVPCTags) {
+	if d.HasChange(isVPCTags) || d.HasChange(flex.ResourceTagsAll) {
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
-		vpc, response, err := sess.GetVPC(getvpcOptions)
+		vpc, response, err := sess.GetVPCWithContext(ctx, getvpcOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+			return flex.NewAPIError(err, response, "vpc", "GetVPC", "ibm_is_vpc", id)
 		}
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCUserTagType)
@@ -1161,9 +1162,9 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
-		vpc, response, err := sess.GetVPC(getvpcOptions)
+		vpc, response, err := sess.GetVPCWithContext(ctx, getvpcOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+			return flex.NewAPIError(err, response, "vpc", "GetVPC", "ibm_is_vpc", id)
 		}
 		oldList, newList := d.GetChange(isVPCAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCAccessTagType)
@@ -1175,17 +1176,17 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 
 	if d.HasChange(isVPCDefaultSecurityGroupName) {
 		if defaultSGName, ok := d.GetOk(isVPCDefaultSecurityGroupName); ok {
-			sgNameUpdate(sess, d.Get(isVPCDefaultSecurityGroup).(string), defaultSGName.(string))
+			sgNameUpdate(ctx, sess, d.Get(isVPCDefaultSecurityGroup).(string), defaultSGName.(string))
 		}
 	}
 	if d.HasChange(isVPCDefaultRoutingTableName) {
 		if defaultRTName, ok := d.GetOk(isVPCDefaultRoutingTableName); ok {
-			rtNameUpdate(sess, id, d.Get(isVPCDefaultRoutingTable).(string), defaultRTName.(string))
+			rtNameUpdate(ctx, sess, id, d.Get(isVPCDefaultRoutingTable).(string), defaultRTName.(string))
 		}
 	}
 	if d.HasChange(isVPCDefaultNetworkACLName) {
 		if defaultACLName, ok := d.GetOk(isVPCDefaultNetworkACLName); ok {
-			nwaclNameUpdate(sess, d.Get(isVPCDefaultNetworkACL).(string), defaultACLName.(string))
+			nwaclNameUpdate(ctx, sess, d.Get(isVPCDefaultNetworkACL).(string), defaultACLName.(string))
 		}
 	}
 	hasDnsChanged := false
@@ -1213,9 +1214,9 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 					getVpcOptions := &vpcv1.GetVPCOptions{
 						ID: &id,
 					}
-					_, response, err := sess.GetVPC(getVpcOptions)
+					_, response, err := sess.GetVPCWithContext(ctx, getVpcOptions)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error Getting VPC (%s): %s\n%s", id, err, response)
+						return flex.NewAPIError(err, response, "vpc", "GetVPC", "ibm_is_vpc", id)
 					}
 					isDnsResolverManualServerChange = true
 					isDnsResolverManualServerEtag = response.Headers.Get("ETag") // Getting Etag from the response headers.
@@ -1307,18 +1308,18 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 
 		updateVpcOptions.VPCPatch = vpcPatch
-		_, response, err := sess.UpdateVPC(updateVpcOptions)
+		_, response, err := sess.UpdateVPCWithContext(ctx, updateVpcOptions)
 		if err != nil {
 			responsestring := strings.ToLower(response.String())
 			if strings.Contains(strings.ToLower(err.Error()), strings.ToLower("The supplied header is not supported for this request")) && strings.Contains(responsestring, "bad_header") && strings.Contains(responsestring, strings.ToLower("If-Match")) {
 				log.Printf("[DEBUG] retrying update vpc without If-Match")
 				updateVpcOptions.IfMatch = nil
-				_, nestedresponse, nestederr := sess.UpdateVPC(updateVpcOptions)
+				_, nestedresponse, nestederr := sess.UpdateVPCWithContext(ctx, updateVpcOptions)
 				if nestederr != nil {
-					return fmt.Errorf("[ERROR] Error Updating VPC on retry : %s\n%s", nestederr, nestedresponse)
+					return flex.NewAPIError(nestederr, nestedresponse, "vpc", "UpdateVPC", "ibm_is_vpc", id)
 				}
 			} else {
-				return fmt.Errorf("[ERROR] Error Updating VPC : %s\n%s", err, response)
+				return flex.NewAPIError(err, response, "vpc", "UpdateVPC", "ibm_is_vpc", id)
 			}
 		}
 		if isDnsResolverVPCCrnNull || isDnsResolverVPCIDNull {
@@ -1338,9 +1339,9 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	return nil
 }
 
-func resourceIBMISVPCDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
-	err := vpcDelete(d, meta, id)
+	err := vpcDelete(ctx, d, meta, id)
 	if err != nil {
 		return err
 	}
@@ -1348,7 +1349,7 @@ func resourceIBMISVPCDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func vpcDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1357,23 +1358,23 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getVpcOptions := &vpcv1.GetVPCOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetVPC(getVpcOptions)
+	_, response, err := sess.GetVPCWithContext(ctx, getVpcOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response, "vpc", "GetVPC", "ibm_is_vpc", id)
 	}
 
 	deletevpcOptions := &vpcv1.DeleteVPCOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteVPC(deletevpcOptions)
+	response, err = sess.DeleteVPCWithContext(ctx, deletevpcOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting VPC : %s\n%s", err, response)
+		return flex.NewAPIError(err, response, "vpc", "DeleteVPC", "ibm_is_vpc", id)
 	}
-	_, err = isWaitForVPCDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPCDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -1381,33 +1382,33 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForVPCDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCDeleted(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isVPCDeleting},
 		Target:     []string{isVPCDeleted, isVPCFailed},
-		Refresh:    isVPCDeleteRefreshFunc(vpc, id),
+		Refresh:    isVPCDeleteRefreshFunc(ctx, vpc, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVPCDeleteRefreshFunc(ctx context.Context, vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is vpc delete function here")
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
-		vpc, response, err := vpc.GetVPC(getvpcOptions)
+		vpc, response, err := vpc.GetVPCWithContext(ctx, getvpcOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return vpc, isVPCDeleted, nil
 			}
-			return nil, isVPCFailed, fmt.Errorf("[ERROR] The VPC %s failed to delete: %s\n%s", id, err, response)
+			return nil, isVPCFailed, flex.NewAPIError(err, response, "vpc", "GetVPC", "ibm_is_vpc", id)
 		}
 
 		return vpc, isVPCDeleting, nil
@@ -1433,7 +1434,7 @@ func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting VPC: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response, "vpc", "GetVPC", "ibm_is_vpc", id)
 	}
 	return true, nil
 }
@@ -1445,7 +1446,7 @@ func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 // 	return conns.String(buf.String())
 // }
 
-func nwaclNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
+func nwaclNameUpdate(ctx context.Context, sess *vpcv1.VpcV1, id, name string) error {
 	updateNetworkACLOptions := &vpcv1.UpdateNetworkACLOptions{
 		ID: &id,
 	}
@@ -1457,14 +1458,14 @@ func nwaclNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 		return fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLPatch: %s", err)
 	}
 	updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
-	_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
+	_, response, err := sess.UpdateNetworkACLWithContext(ctx, updateNetworkACLOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) name : %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response, "vpc", "UpdateNetworkACL", "ibm_is_vpc", "")
 	}
 	return nil
 }
 
-func sgNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
+func sgNameUpdate(ctx context.Context, sess *vpcv1.VpcV1, id, name string) error {
 	updateSecurityGroupOptions := &vpcv1.UpdateSecurityGroupOptions{
 		ID: &id,
 	}
@@ -1476,14 +1477,14 @@ func sgNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 		return fmt.Errorf("[ERROR] Error calling asPatch for SecurityGroupPatch: %s", err)
 	}
 	updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
-	_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
+	_, response, err := sess.UpdateSecurityGroupWithContext(ctx, updateSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Security Group name : %s\n%s", err, response)
+		return flex.NewAPIError(err, response, "vpc", "UpdateSecurityGroup", "ibm_is_vpc", "")
 	}
 	return nil
 }
 
-func rtNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
+func rtNameUpdate(ctx context.Context, sess *vpcv1.VpcV1, vpcID, id, name string) error {
 	updateVpcRoutingTableOptions := new(vpcv1.UpdateVPCRoutingTableOptions)
 	updateVpcRoutingTableOptions.VPCID = &vpcID
 	updateVpcRoutingTableOptions.ID = &id
@@ -1494,9 +1495,9 @@ func rtNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
 		return fmt.Errorf("[ERROR] Error calling asPatch for RoutingTablePatchModel: %s", asPatchErr)
 	}
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
-	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
+	_, response, err := sess.UpdateVPCRoutingTableWithContext(ctx, updateVpcRoutingTableOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Routing table name %s\n%s", err, response)
+		return flex.NewAPIError(err, response, "vpc", "UpdateVPCRoutingTable", "ibm_is_vpc", vpcID)
 	}
 	return nil
 }
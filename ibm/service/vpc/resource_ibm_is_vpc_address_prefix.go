@@ -125,13 +125,18 @@ func resourceIBMISVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{
 	}
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutCreate, isVPCAddressPrefixKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
-	err := vpcAddressPrefixCreate(d, meta, prefixName, zoneName, cidr, vpcID, isDefault)
+	err = vpcAddressPrefixCreate(d, meta, prefixName, zoneName, cidr, vpcID, isDefault)
 	if err != nil {
 		return err
 	}
+	// Read takes the lock for reading
+	unlock()
 	return resourceIBMISVpcAddressPrefixRead(d, meta)
 }
 
@@ -168,6 +173,11 @@ func resourceIBMISVpcAddressPrefixRead(d *schema.ResourceData, meta interface{})
 
 	vpcID := parts[0]
 	addrPrefixID := parts[1]
+	unlock, err := conns.IbmLockManager.RLockOperation(d, meta, schema.TimeoutRead, "vpc_address_prefix_key_"+vpcID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 	error := vpcAddressPrefixGet(d, meta, vpcID, addrPrefixID)
 	if error != nil {
 		return error
@@ -229,8 +239,11 @@ func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{
 	addrPrefixID := parts[1]
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutUpdate, isVPCAddressPrefixKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if d.HasChange(isVPCAddressPrefixPrefixName) {
 		name = d.Get(isVPCAddressPrefixPrefixName).(string)
@@ -245,6 +258,8 @@ func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{
 		return error
 	}
 
+	// Read takes the lock for reading
+	unlock()
 	return resourceIBMISVpcAddressPrefixRead(d, meta)
 }
 
@@ -289,8 +304,11 @@ func resourceIBMISVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{
 	addrPrefixID := parts[1]
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	unlock, err := conns.IbmLockManager.LockOperation(d, meta, schema.TimeoutDelete, isVPCAddressPrefixKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	error := vpcAddressPrefixDelete(d, meta, vpcID, addrPrefixID)
 	if error != nil {
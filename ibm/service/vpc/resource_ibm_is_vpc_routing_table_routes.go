@@ -0,0 +1,559 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	rRoutes   = "routes"
+	rPriority = "priority"
+
+	// The number of routes created, updated or deleted at the same time, the
+	// requests throttled by the API are retried by the provider retry policy
+	isRoutingTableRoutesParallelism = 5
+	// The next hop the API reports for the routes that don't deliver
+	isRoutingTableRouteNoNextHop = "0.0.0.0"
+)
+
+func ResourceIBMISVPCRoutingTableRoutes() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISVPCRoutingTableRoutesCreate,
+		ReadContext:   resourceIBMISVPCRoutingTableRoutesRead,
+		UpdateContext: resourceIBMISVPCRoutingTableRoutesUpdate,
+		DeleteContext: resourceIBMISVPCRoutingTableRoutesDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: resourceIBMISVPCRoutingTableRoutesCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			rtVpcID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The VPC identifier.",
+			},
+			rtID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The routing table identifier.",
+			},
+			rRoutes: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The complete list of custom routes of the routing table, the routes not listed are removed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						rID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The routing table route identifier.",
+						},
+						rDestination: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateCIDR,
+							Description:  "The destination of the route.",
+						},
+						rZone: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The zone to apply the route to. Traffic from subnets in this zone will be subject to this route.",
+						},
+						rAction: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "deliver",
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rAction),
+							Description:  "The action to perform with a packet matching the route.",
+						},
+						rNextHop: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "If action is deliver, the IP address or the VPN gateway connection the packets are delivered to. Not set for the other actions.",
+						},
+						rName: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rName),
+							Description:  "The user-defined name for this route, generated by the API when not set.",
+						},
+						rPriority: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rPriority),
+							Description:  "The route's priority. Smaller values have higher priority.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceIBMISVPCRoutingTableRoutesCustomizeDiff rejects at plan time the
+// routes the API would reject, or that would make the table ambiguous. The
+// routes with a destination or zone known only after apply are not checked.
+func resourceIBMISVPCRoutingTableRoutesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	return validateIBMISRoutingTableRoutes(config.GetAttr(rRoutes))
+}
+
+// validateIBMISRoutingTableRoutes checks the next hop of each configured
+// route, and that no two destinations overlap in a zone.
+func validateIBMISRoutingTableRoutes(routes cty.Value) error {
+	if routes.IsNull() || !routes.IsKnown() {
+		return nil
+	}
+
+	byZone := map[string][]*net.IPNet{}
+	for it := routes.ElementIterator(); it.Next(); {
+		_, route := it.Element()
+		destination, zone := route.GetAttr(rDestination), route.GetAttr(rZone)
+		if destination.IsKnown() && !destination.IsNull() {
+			if err := validateIBMISRoutingTableRouteNextHop(destination.AsString(), route.GetAttr(rAction), route.GetAttr(rNextHop)); err != nil {
+				return err
+			}
+		}
+		if !destination.IsKnown() || destination.IsNull() || !zone.IsKnown() || zone.IsNull() {
+			continue
+		}
+		if _, errs := validate.ValidateCIDR(destination.AsString(), rDestination); len(errs) > 0 {
+			return errs[0]
+		}
+		_, ipNet, _ := net.ParseCIDR(destination.AsString())
+		if ipNet.String() != destination.AsString() {
+			return fmt.Errorf("the destination %q of a route is not the address of its network, use %q", destination.AsString(), ipNet.String())
+		}
+		for _, other := range byZone[zone.AsString()] {
+			if other.String() == ipNet.String() {
+				return fmt.Errorf("the destination %q is routed more than once in zone %s", ipNet, zone.AsString())
+			}
+			if other.Contains(ipNet.IP) || ipNet.Contains(other.IP) {
+				return fmt.Errorf("the destinations %q and %q overlap in zone %s, a destination can only be routed once per zone", other, ipNet, zone.AsString())
+			}
+		}
+		byZone[zone.AsString()] = append(byZone[zone.AsString()], ipNet)
+	}
+	return nil
+}
+
+// validateIBMISRoutingTableRouteNextHop checks that only the routes delivering
+// the packets have a next hop, an IPv4 address or a VPN gateway connection.
+func validateIBMISRoutingTableRouteNextHop(destination string, actionValue, nextHopValue cty.Value) error {
+	if !actionValue.IsKnown() || !nextHopValue.IsKnown() {
+		return nil
+	}
+	action := "deliver"
+	if !actionValue.IsNull() {
+		action = actionValue.AsString()
+	}
+	nextHop := ""
+	if !nextHopValue.IsNull() {
+		nextHop = nextHopValue.AsString()
+	}
+	if action != "deliver" {
+		if nextHop != "" && nextHop != isRoutingTableRouteNoNextHop {
+			return fmt.Errorf("the route to %q with action %q cannot have a next_hop, only the deliver routes have one", destination, action)
+		}
+		return nil
+	}
+	if nextHop == "" {
+		return fmt.Errorf("the route to %q with action %q requires a next_hop", destination, action)
+	}
+	if ip := net.ParseIP(nextHop); ip != nil && (ip.To4() == nil || ip.IsUnspecified()) {
+		return fmt.Errorf("the next_hop %q of the route to %q must be an IPv4 address other than %s, or a VPN gateway connection", nextHop, destination, isRoutingTableRouteNoNextHop)
+	}
+	return nil
+}
+
+func resourceIBMISVPCRoutingTableRoutesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcID := d.Get(rtVpcID).(string)
+	tableID := d.Get(rtID).(string)
+	// The routes created before a failure are kept in the state
+	d.SetId(fmt.Sprintf("%s/%s", vpcID, tableID))
+	if err := applyIBMISVPCRoutingTableRoutes(context, d, meta, vpcID, tableID); err != nil {
+		return flex.DiagFromErr(err)
+	}
+	return resourceIBMISVPCRoutingTableRoutesRead(context, d, meta)
+}
+
+func resourceIBMISVPCRoutingTableRoutesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vpcID, tableID, err := parseIBMISVPCRoutingTableRoutesID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	current, response, err := listIBMISVPCRoutingTableCustomRoutes(context, sess, vpcID, tableID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return flex.APIErrorDiag(err, response, "vpc", "ListVPCRoutingTableRoutes", "ibm_is_vpc_routing_table_routes", tableID)
+	}
+
+	// The routes keep the order of the state, that of the configuration, the
+	// others follow by zone and destination
+	order := map[string]int{}
+	for i, route := range expandRoutingTableRouteSpecs(d.Get(rRoutes).([]interface{})) {
+		order[route.key()] = i
+	}
+	sort.SliceStable(current, func(i, j int) bool {
+		oi, iok := order[current[i].key()]
+		oj, jok := order[current[j].key()]
+		if iok && jok {
+			return oi < oj
+		}
+		return iok && !jok
+	})
+	routes := make([]interface{}, 0, len(current))
+	for _, route := range current {
+		// The API reports a next hop for the routes that don't deliver
+		if route.nextHop == isRoutingTableRouteNoNextHop {
+			route.nextHop = ""
+		}
+		routes = append(routes, route.toMap())
+	}
+
+	d.Set(rtVpcID, vpcID)
+	d.Set(rtID, tableID)
+	if err = d.Set(rRoutes, routes); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting routes: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMISVPCRoutingTableRoutesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(rRoutes) {
+		vpcID, tableID, err := parseIBMISVPCRoutingTableRoutesID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := applyIBMISVPCRoutingTableRoutes(context, d, meta, vpcID, tableID); err != nil {
+			return flex.DiagFromErr(err)
+		}
+	}
+	return resourceIBMISVPCRoutingTableRoutesRead(context, d, meta)
+}
+
+func resourceIBMISVPCRoutingTableRoutesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcID, tableID, err := parseIBMISVPCRoutingTableRoutesID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(rRoutes, nil)
+	if err := applyIBMISVPCRoutingTableRoutes(context, d, meta, vpcID, tableID); err != nil {
+		return flex.DiagFromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func parseIBMISVPCRoutingTableRoutesID(id string) (string, string, error) {
+	idSet := strings.Split(id, "/")
+	if len(idSet) != 2 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of vpcID/routingTableID", id)
+	}
+	return idSet[0], idSet[1], nil
+}
+
+// applyIBMISVPCRoutingTableRoutes makes the custom routes of the table those
+// of the configuration. The routes are matched by zone and destination: the
+// routes no longer configured, or whose action changed, are deleted first,
+// then the others are updated and the new ones created, several at a time.
+func applyIBMISVPCRoutingTableRoutes(ctx context.Context, d *schema.ResourceData, meta interface{}, vpcID, tableID string) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	current, response, err := listIBMISVPCRoutingTableCustomRoutes(ctx, sess, vpcID, tableID)
+	if err != nil {
+		return flex.NewAPIError(err, response, "vpc", "ListVPCRoutingTableRoutes", "ibm_is_vpc_routing_table_routes", tableID)
+	}
+	currentByKey := map[string]routingTableRouteSpec{}
+	for _, route := range current {
+		currentByKey[route.key()] = route
+	}
+
+	// A name not configured is the one planned for the route at the same
+	// index, it may be the name of another route
+	var configured cty.Value
+	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() {
+		configured = config.GetAttr(rRoutes)
+	}
+	var deletes, updates, creates []func(context.Context) error
+	desired := map[string]bool{}
+	for i, route := range expandRoutingTableRouteSpecs(d.Get(rRoutes).([]interface{})) {
+		route := route
+		if configured == cty.NilVal || configured.IsNull() || !configured.IsKnown() || configured.LengthInt() <= i ||
+			configured.Index(cty.NumberIntVal(int64(i))).GetAttr(rName).IsNull() {
+			route.name = ""
+		}
+		desired[route.key()] = true
+		existing, ok := currentByKey[route.key()]
+		if ok && existing.action == route.action {
+			if patch := route.patch(existing); patch != nil {
+				updates = append(updates, func(ctx context.Context) error {
+					updateVpcRoutingTableRouteOptions := sess.NewUpdateVPCRoutingTableRouteOptions(vpcID, tableID, existing.id, patch)
+					_, response, err := sess.UpdateVPCRoutingTableRouteWithContext(ctx, updateVpcRoutingTableRouteOptions)
+					if err != nil {
+						return flex.NewAPIError(err, response, "vpc", "UpdateVPCRoutingTableRoute", "ibm_is_vpc_routing_table_routes", existing.id)
+					}
+					return nil
+				})
+			}
+			continue
+		}
+		if ok {
+			// The action of a route can't be changed, it is replaced
+			desired[route.key()] = false
+		}
+		creates = append(creates, func(ctx context.Context) error {
+			_, response, err := sess.CreateVPCRoutingTableRouteWithContext(ctx, route.createOptions(sess, vpcID, tableID))
+			if err != nil {
+				return flex.NewAPIError(err, response, "vpc", "CreateVPCRoutingTableRoute", "ibm_is_vpc_routing_table_routes", tableID)
+			}
+			return nil
+		})
+	}
+	for _, route := range current {
+		route := route
+		if desired[route.key()] {
+			continue
+		}
+		deletes = append(deletes, func(ctx context.Context) error {
+			deleteVpcRoutingTableRouteOptions := sess.NewDeleteVPCRoutingTableRouteOptions(vpcID, tableID, route.id)
+			response, err := sess.DeleteVPCRoutingTableRouteWithContext(ctx, deleteVpcRoutingTableRouteOptions)
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				return flex.NewAPIError(err, response, "vpc", "DeleteVPCRoutingTableRoute", "ibm_is_vpc_routing_table_routes", route.id)
+			}
+			return nil
+		})
+	}
+
+	log.Printf("[INFO] Changing the routes of routing table %s: %d to delete, %d to update, %d to create", tableID, len(deletes), len(updates), len(creates))
+	// Delete first, so that the replaced routes don't conflict with the new ones
+	if err := runIBMISVPCRoutingTableRouteRequests(ctx, deletes); err != nil {
+		return err
+	}
+	return runIBMISVPCRoutingTableRouteRequests(ctx, append(updates, creates...))
+}
+
+// runIBMISVPCRoutingTableRouteRequests runs the requests, at most
+// isRoutingTableRoutesParallelism at a time. The requests not started yet
+// are skipped after a failure, and the first failure is returned.
+func runIBMISVPCRoutingTableRouteRequests(ctx context.Context, requests []func(context.Context) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	slots := make(chan struct{}, isRoutingTableRoutesParallelism)
+	for _, request := range requests {
+		slots <- struct{}{}
+		if ctx.Err() != nil {
+			<-slots
+			break
+		}
+		wg.Add(1)
+		go func(request func(context.Context) error) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			if err := request(ctx); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+				cancel()
+			}
+		}(request)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	// The operation timed out
+	return ctx.Err()
+}
+
+// listIBMISVPCRoutingTableCustomRoutes returns the routes of the table created
+// by users, the routes learned or created by services are left out.
+func listIBMISVPCRoutingTableCustomRoutes(context context.Context, sess *vpcv1.VpcV1, vpcID, tableID string) ([]routingTableRouteSpec, *core.DetailedResponse, error) {
+	routes, response, err := listIBMISVPCRoutingTableRoutes(context, sess, vpcID, tableID)
+	if err != nil {
+		return nil, response, err
+	}
+	custom := make([]routingTableRouteSpec, 0, len(routes))
+	for _, route := range routes {
+		if route.Creator != nil || (route.Origin != nil && *route.Origin != "user") {
+			continue
+		}
+		custom = append(custom, routingTableRouteSpecFromRoute(route))
+	}
+	sort.Slice(custom, func(i, j int) bool { return custom[i].key() < custom[j].key() })
+	return custom, nil, nil
+}
+
+// routingTableRouteSpec is a route of a routing table, its next hop is an IP
+// address or the ID of a VPN gateway connection.
+type routingTableRouteSpec struct {
+	id          string
+	destination string
+	zone        string
+	action      string
+	nextHop     string
+	name        string
+	priority    int64
+}
+
+func (r routingTableRouteSpec) key() string {
+	return r.zone + "/" + r.destination
+}
+
+func (r routingTableRouteSpec) createOptions(sess *vpcv1.VpcV1, vpcID, tableID string) *vpcv1.CreateVPCRoutingTableRouteOptions {
+	zone := &vpcv1.ZoneIdentityByName{
+		Name: core.StringPtr(r.zone),
+	}
+	createVpcRoutingTableRouteOptions := sess.NewCreateVPCRoutingTableRouteOptions(vpcID, tableID, r.destination, zone)
+	createVpcRoutingTableRouteOptions.SetAction(r.action)
+	createVpcRoutingTableRouteOptions.SetPriority(r.priority)
+	if r.name != "" {
+		createVpcRoutingTableRouteOptions.SetName(r.name)
+	}
+	if r.nextHop != "" {
+		if net.ParseIP(r.nextHop) == nil {
+			createVpcRoutingTableRouteOptions.SetNextHop(&vpcv1.RoutePrototypeNextHopRouteNextHopPrototypeVPNGatewayConnectionIdentity{
+				ID: core.StringPtr(r.nextHop),
+			})
+		} else {
+			createVpcRoutingTableRouteOptions.SetNextHop(&vpcv1.RoutePrototypeNextHopRouteNextHopPrototypeRouteNextHopIP{
+				Address: core.StringPtr(r.nextHop),
+			})
+		}
+	}
+	return createVpcRoutingTableRouteOptions
+}
+
+// patch returns the patch turning the existing route into this one, or nil if
+// they are the same. An unset name or next hop is left as it is.
+func (r routingTableRouteSpec) patch(existing routingTableRouteSpec) map[string]interface{} {
+	routePatchModel := &vpcv1.RoutePatch{}
+	hasChange := false
+	if r.name != "" && r.name != existing.name {
+		routePatchModel.Name = core.StringPtr(r.name)
+		hasChange = true
+	}
+	if r.priority != existing.priority {
+		routePatchModel.Priority = core.Int64Ptr(r.priority)
+		hasChange = true
+	}
+	if r.nextHop != "" && r.nextHop != existing.nextHop {
+		if net.ParseIP(r.nextHop) == nil {
+			routePatchModel.NextHop = &vpcv1.RouteNextHopPatch{
+				ID: core.StringPtr(r.nextHop),
+			}
+		} else {
+			routePatchModel.NextHop = &vpcv1.RouteNextHopPatch{
+				Address: core.StringPtr(r.nextHop),
+			}
+		}
+		hasChange = true
+	}
+	if !hasChange {
+		return nil
+	}
+	// AsPatch only fails on values that can't be encoded as JSON
+	patch, _ := routePatchModel.AsPatch()
+	return patch
+}
+
+func (r routingTableRouteSpec) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		rID:          r.id,
+		rDestination: r.destination,
+		rZone:        r.zone,
+		rAction:      r.action,
+		rNextHop:     r.nextHop,
+		rName:        r.name,
+		rPriority:    int(r.priority),
+	}
+}
+
+func expandRoutingTableRouteSpecs(routes []interface{}) []routingTableRouteSpec {
+	specs := make([]routingTableRouteSpec, 0, len(routes))
+	for _, v := range routes {
+		if v == nil {
+			continue
+		}
+		m := v.(map[string]interface{})
+		specs = append(specs, routingTableRouteSpec{
+			id:          m[rID].(string),
+			destination: m[rDestination].(string),
+			zone:        m[rZone].(string),
+			action:      m[rAction].(string),
+			nextHop:     m[rNextHop].(string),
+			name:        m[rName].(string),
+			priority:    int64(m[rPriority].(int)),
+		})
+	}
+	return specs
+}
+
+func routingTableRouteSpecFromRoute(route vpcv1.Route) routingTableRouteSpec {
+	spec := routingTableRouteSpec{
+		id:          *route.ID,
+		destination: *route.Destination,
+		action:      *route.Action,
+		name:        *route.Name,
+	}
+	if route.Zone != nil {
+		spec.zone = *route.Zone.Name
+	}
+	if route.Priority != nil {
+		spec.priority = *route.Priority
+	}
+	if nexthop, ok := route.NextHop.(*vpcv1.RouteNextHop); ok && nexthop != nil {
+		if nexthop.Address != nil {
+			spec.nextHop = *nexthop.Address
+		}
+		if nexthop.ID != nil {
+			spec.nextHop = *nexthop.ID
+		}
+	}
+	return spec
+}
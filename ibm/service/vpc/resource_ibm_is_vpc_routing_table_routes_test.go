@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISVPCRoutingTableRoutes_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfvpcroutes-vpc-%d", acctest.RandIntRange(10, 100))
+	rtname := fmt.Sprintf("tfvpcroutes-rt-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCRoutingTableRoutesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCRoutingTableRoutesConfig(vpcname, rtname, "192.168.1.0/24", "192.168.2.0/24", "192.168.3.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCRoutingTableRoutesCount("ibm_is_vpc_routing_table_routes.testacc_routes", 4),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table_routes.testacc_routes", "routes.#", "4"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCRoutingTableRoutesConfig(vpcname, rtname, "192.168.1.0/24", "192.168.4.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCRoutingTableRoutesCount("ibm_is_vpc_routing_table_routes.testacc_routes", 3),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table_routes.testacc_routes", "routes.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table_routes.testacc_routes", "routes.2.destination", "192.168.4.0/24"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_vpc_routing_table_routes.testacc_routes", "routes.0.name"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpc_routing_table_routes.testacc_routes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMISVPCRoutingTableRoutes_overlap(t *testing.T) {
+	vpcname := fmt.Sprintf("tfvpcroutes-vpc-%d", acctest.RandIntRange(10, 100))
+	rtname := fmt.Sprintf("tfvpcroutes-rt-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISVPCRoutingTableRoutesConfig(vpcname, rtname, "192.168.0.0/16", "192.168.2.0/24"),
+				ExpectError: regexp.MustCompile("overlap"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCRoutingTableRoutesDestroy(s *terraform.State) error {
+	sess, err := vpcClient(acc.TestAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_vpc_routing_table_routes" {
+			continue
+		}
+		parts := strings.Split(rs.Primary.ID, "/")
+		routes, _, err := sess.ListVPCRoutingTableRoutes(sess.NewListVPCRoutingTableRoutesOptions(parts[0], parts[1]))
+		if err == nil && len(routes.Routes) > 0 {
+			return fmt.Errorf("routing table %s still has %d routes", parts[1], len(routes.Routes))
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISVPCRoutingTableRoutesCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		sess, err := vpcClient(acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		parts := strings.Split(rs.Primary.ID, "/")
+		routes, _, err := sess.ListVPCRoutingTableRoutes(sess.NewListVPCRoutingTableRoutesOptions(parts[0], parts[1]))
+		if err != nil {
+			return err
+		}
+		if len(routes.Routes) != count {
+			return fmt.Errorf("expected %d routes in routing table %s, got %d", count, parts[1], len(routes.Routes))
+		}
+		return nil
+	}
+}
+
+// testAccCheckIBMISVPCRoutingTableRoutesConfig lists the routes by zone and
+// destination, the order of the imported routes.
+func testAccCheckIBMISVPCRoutingTableRoutesConfig(vpcname, rtname string, destinations ...string) string {
+	var routes strings.Builder
+	for _, destination := range destinations {
+		fmt.Fprintf(&routes, `
+		routes {
+			zone        = "%s"
+			destination = "%s"
+			next_hop    = "%s"
+		}`, acc.ISZoneName, destination, acc.ISRouteNextHop)
+	}
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_vpc_routing_table" "testacc_rt" {
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		name = "%s"
+	}
+
+	resource "ibm_is_vpc_routing_table_routes" "testacc_routes" {
+		vpc           = ibm_is_vpc.testacc_vpc.id
+		routing_table = ibm_is_vpc_routing_table.testacc_rt.routing_table
+		routes {
+			zone        = "%s"
+			destination = "10.10.0.0/16"
+			action      = "drop"
+		}
+		%s
+	}`, vpcname, rtname, acc.ISZoneName, routes.String())
+}
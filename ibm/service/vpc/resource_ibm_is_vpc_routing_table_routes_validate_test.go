@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestValidateIBMISRoutingTableRouteNextHop(t *testing.T) {
+	cases := []struct {
+		name    string
+		action  cty.Value
+		nextHop cty.Value
+		err     string
+	}{
+		{name: "deliver to an address", action: cty.StringVal("deliver"), nextHop: cty.StringVal("10.240.0.4")},
+		{name: "deliver to a VPN gateway connection", action: cty.StringVal("deliver"), nextHop: cty.StringVal("r006-connection")},
+		{name: "deliver by default", action: cty.NullVal(cty.String), nextHop: cty.StringVal("10.240.0.4")},
+		{name: "deliver without a next hop", action: cty.StringVal("deliver"), nextHop: cty.NullVal(cty.String), err: "requires a next_hop"},
+		{name: "deliver by default without a next hop", action: cty.NullVal(cty.String), nextHop: cty.StringVal(""), err: "requires a next_hop"},
+		{name: "deliver to no address", action: cty.StringVal("deliver"), nextHop: cty.StringVal(isRoutingTableRouteNoNextHop), err: "must be an IPv4 address"},
+		{name: "deliver to an IPv6 address", action: cty.StringVal("deliver"), nextHop: cty.StringVal("fe80::1"), err: "must be an IPv4 address"},
+		{name: "drop without a next hop", action: cty.StringVal("drop"), nextHop: cty.NullVal(cty.String)},
+		{name: "drop to no address", action: cty.StringVal("drop"), nextHop: cty.StringVal(isRoutingTableRouteNoNextHop)},
+		{name: "drop with a next hop", action: cty.StringVal("drop"), nextHop: cty.StringVal("10.240.0.4"), err: "cannot have a next_hop"},
+		{name: "delegate with a next hop", action: cty.StringVal("delegate"), nextHop: cty.StringVal("10.240.0.4"), err: "cannot have a next_hop"},
+		{name: "unknown action", action: cty.UnknownVal(cty.String), nextHop: cty.StringVal("10.240.0.4")},
+		{name: "unknown next hop", action: cty.StringVal("deliver"), nextHop: cty.UnknownVal(cty.String)},
+	}
+	for _, c := range cases {
+		err := validateIBMISRoutingTableRouteNextHop("10.0.0.0/24", c.action, c.nextHop)
+		checkRoutingTableRoutesError(t, c.name, err, c.err)
+	}
+}
+
+func TestValidateIBMISRoutingTableRoutes(t *testing.T) {
+	route := func(destination, zone cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			rDestination: destination,
+			rZone:        zone,
+			rAction:      cty.StringVal("deliver"),
+			rNextHop:     cty.StringVal("10.240.0.4"),
+		})
+	}
+	routes := func(routes ...cty.Value) cty.Value {
+		return cty.ListVal(routes)
+	}
+	zone1, zone2 := cty.StringVal("us-south-1"), cty.StringVal("us-south-2")
+	cases := []struct {
+		name   string
+		routes cty.Value
+		err    string
+	}{
+		{name: "no routes", routes: cty.NullVal(cty.List(route(cty.StringVal("10.0.0.0/24"), zone1).Type()))},
+		{
+			name:   "disjoint destinations",
+			routes: routes(route(cty.StringVal("10.0.0.0/24"), zone1), route(cty.StringVal("10.0.1.0/24"), zone1)),
+		},
+		{
+			name:   "a destination in two zones",
+			routes: routes(route(cty.StringVal("10.0.0.0/24"), zone1), route(cty.StringVal("10.0.0.0/24"), zone2)),
+		},
+		{
+			name:   "overlapping destinations in two zones",
+			routes: routes(route(cty.StringVal("10.0.0.0/16"), zone1), route(cty.StringVal("10.0.1.0/24"), zone2)),
+		},
+		{
+			name:   "a destination twice in a zone",
+			routes: routes(route(cty.StringVal("10.0.0.0/24"), zone1), route(cty.StringVal("10.0.0.0/24"), zone1)),
+			err:    "routed more than once in zone us-south-1",
+		},
+		{
+			name:   "a destination inside another in a zone",
+			routes: routes(route(cty.StringVal("10.0.0.0/16"), zone1), route(cty.StringVal("10.0.1.0/24"), zone1)),
+			err:    "overlap in zone us-south-1",
+		},
+		{
+			name:   "a destination around another in a zone",
+			routes: routes(route(cty.StringVal("10.0.1.0/24"), zone2), route(cty.StringVal("10.0.0.0/8"), zone2)),
+			err:    "overlap in zone us-south-2",
+		},
+		{
+			name:   "a destination that is not a network address",
+			routes: routes(route(cty.StringVal("10.0.0.5/24"), zone1)),
+			err:    `use "10.0.0.0/24"`,
+		},
+		{
+			name:   "a destination known after apply",
+			routes: routes(route(cty.StringVal("10.0.0.0/24"), zone1), route(cty.UnknownVal(cty.String), zone1)),
+		},
+		{
+			name:   "a zone known after apply",
+			routes: routes(route(cty.StringVal("10.0.0.0/24"), zone1), route(cty.StringVal("10.0.0.0/24"), cty.UnknownVal(cty.String))),
+		},
+		{
+			name: "a next hop of a route known after apply",
+			routes: routes(cty.ObjectVal(map[string]cty.Value{
+				rDestination: cty.StringVal("10.0.0.0/24"),
+				rZone:        zone1,
+				rAction:      cty.StringVal("drop"),
+				rNextHop:     cty.StringVal("10.240.0.4"),
+			})),
+			err: "cannot have a next_hop",
+		},
+	}
+	for _, c := range cases {
+		checkRoutingTableRoutesError(t, c.name, validateIBMISRoutingTableRoutes(c.routes), c.err)
+	}
+}
+
+func checkRoutingTableRoutesError(t *testing.T, name string, err error, expected string) {
+	t.Helper()
+	switch {
+	case expected == "" && err != nil:
+		t.Errorf("%s: unexpected error %s", name, err)
+	case expected != "" && err == nil:
+		t.Errorf("%s: expected an error containing %q", name, expected)
+	case expected != "" && !strings.Contains(err.Error(), expected):
+		t.Errorf("%s: expected an error containing %q, got %s", name, expected, err)
+	}
+}
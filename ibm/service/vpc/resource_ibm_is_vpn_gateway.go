@@ -56,7 +56,7 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -120,6 +120,7 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 				Description: "The Second Private IP address assigned to the VPN gateway member.",
 			},
 
+			flex.ResourceTagsAll: flex.ResourceTagsAllSchema(),
 			isVPNGatewayTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -363,7 +364,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPNGatewayTags); ok || v != "" {
+	if _, ok := d.GetOk(isVPNGatewayTags); ok || v != "" || flex.HasDefaultTags(meta) {
 		oldList, newList := d.GetChange(isVPNGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isUserTagType)
 		if err != nil {
@@ -546,7 +547,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isVPNGatewayTags) {
+	if d.HasChange(isVPNGatewayTags) || d.HasChange(flex.ResourceTagsAll) {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
@@ -9,7 +9,9 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -53,6 +55,10 @@ func ResourceIBMIsVPNServer() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(context context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMIsVPNServerCertificateVersionsCustomizeDiff(context, diff, v)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -62,6 +68,18 @@ func ResourceIBMIsVPNServer() *schema.Resource {
 				ForceNew:    false,
 				Description: "The crn of certificate instance for this VPN server.",
 			},
+			"rotate_certificates": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to `true`, the VPN server is updated to use the new version of its Secrets Manager certificates when they are rotated.",
+			},
+			"certificate_versions": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The versions of the Secrets Manager certificates this VPN server was last updated with, by certificate CRN. Only set if `rotate_certificates` is `true`.",
+			},
 			"client_authentication": &schema.Schema{
 				Type:        schema.TypeList,
 				Required:    true,
@@ -522,6 +540,14 @@ func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if d.Get("rotate_certificates").(bool) {
+		versions, err := getIBMIsVPNServerCertificateVersions(context, meta, resourceIBMIsVPNServerCertificateCRNs(d.Get("certificate_crn").(string), d.Get("client_authentication").([]interface{})))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("certificate_versions", versions)
+	}
+
 	return resourceIBMIsVPNServerRead(context, d, meta)
 }
 
@@ -772,8 +798,32 @@ func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceDat
 	updateVPNServerOptions.SetID(d.Id())
 	hasChange := false
 
+	// The rotated certificates are updated with the same CRN, for the VPN
+	// server to use their new version.
+	certificateRotated, clientCARotated := false, false
+	var versions map[string]interface{}
+	if d.Get("rotate_certificates").(bool) {
+		versions = d.Get("certificate_versions").(map[string]interface{})
+		if len(versions) == 0 {
+			versions, err = getIBMIsVPNServerCertificateVersions(context, meta, resourceIBMIsVPNServerCertificateCRNs(d.Get("certificate_crn").(string), d.Get("client_authentication").([]interface{})))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		oldVersions, _ := d.GetChange("certificate_versions")
+		for crn, version := range versions {
+			if oldVersion, ok := oldVersions.(map[string]interface{})[crn]; ok && oldVersion != version {
+				if crn == d.Get("certificate_crn").(string) {
+					certificateRotated = true
+				} else {
+					clientCARotated = true
+				}
+			}
+		}
+	}
+
 	patchVals := &vpcv1.VPNServerPatch{}
-	if d.HasChange("certificate_crn") {
+	if d.HasChange("certificate_crn") || certificateRotated {
 		crn_val := d.Get("certificate_crn").(string)
 
 		certificateInstanceIdentity := &vpcv1.CertificateInstanceIdentity{}
@@ -782,7 +832,7 @@ func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceDat
 		hasChange = true
 	}
 
-	if d.HasChange("client_authentication") {
+	if d.HasChange("client_authentication") || clientCARotated {
 		var clientAuthentication []vpcv1.VPNServerAuthenticationPrototypeIntf
 		clientAuthArray := d.Get("client_authentication").([]interface{})
 		for _, clientauth := range clientAuthArray {
@@ -910,10 +960,92 @@ func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceDat
 			return diag.FromErr(fmt.Errorf("[ERROR] VPNServer failed %s\n", err))
 		}
 	}
+	d.Set("certificate_versions", versions)
 
 	return resourceIBMIsVPNServerRead(context, d, meta)
 }
 
+// resourceIBMIsVPNServerCertificateVersionsCustomizeDiff plans an update of
+// the VPN server when one of its certificates got a new version in Secrets
+// Manager, and forgets the versions when the rotation is disabled. Secrets
+// Manager is only called when the rotation is enabled and the certificates
+// are unchanged, otherwise the update gets the versions of the new ones.
+func resourceIBMIsVPNServerCertificateVersionsCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	oldVersions := diff.Get("certificate_versions").(map[string]interface{})
+	if !diff.Get("rotate_certificates").(bool) {
+		if len(oldVersions) > 0 {
+			return diff.SetNew("certificate_versions", map[string]interface{}{})
+		}
+		return nil
+	}
+	if diff.HasChange("rotate_certificates") || diff.HasChange("certificate_crn") || diff.HasChange("client_authentication") {
+		return diff.SetNewComputed("certificate_versions")
+	}
+	if !diff.NewValueKnown("certificate_crn") || !diff.NewValueKnown("client_authentication") {
+		return diff.SetNewComputed("certificate_versions")
+	}
+	for i := range diff.Get("client_authentication").([]interface{}) {
+		if !diff.NewValueKnown(fmt.Sprintf("client_authentication.%d.client_ca_crn", i)) {
+			return diff.SetNewComputed("certificate_versions")
+		}
+	}
+
+	versions, err := getIBMIsVPNServerCertificateVersions(context, meta, resourceIBMIsVPNServerCertificateCRNs(diff.Get("certificate_crn").(string), diff.Get("client_authentication").([]interface{})))
+	if err != nil {
+		return err
+	}
+	if len(versions) != len(oldVersions) {
+		return diff.SetNew("certificate_versions", versions)
+	}
+	for crn, version := range versions {
+		if oldVersions[crn] != version {
+			return diff.SetNew("certificate_versions", versions)
+		}
+	}
+	return nil
+}
+
+// resourceIBMIsVPNServerCertificateCRNs returns the CRNs of the server
+// certificate and of the client CA certificates.
+func resourceIBMIsVPNServerCertificateCRNs(certificateCRN string, clientAuthentication []interface{}) []string {
+	crns := []string{certificateCRN}
+	for _, clientauth := range clientAuthentication {
+		clientAuth, ok := clientauth.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if clientCaCRN, ok := clientAuth["client_ca_crn"].(string); ok && clientCaCRN != "" {
+			crns = append(crns, clientCaCRN)
+		}
+	}
+	return crns
+}
+
+// getIBMIsVPNServerCertificateVersions returns the current version of the
+// Secrets Manager certificates, by CRN.
+func getIBMIsVPNServerCertificateVersions(context context.Context, meta interface{}, crns []string) (map[string]interface{}, error) {
+	smClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return nil, err
+	}
+	versions := make(map[string]interface{}, len(crns))
+	for _, crn := range crns {
+		client, secretID, err := secretsmanager.GetClientWithSecretCRN(smClient, crn)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error rotating the certificates of the VPN server: %s", err)
+		}
+		version, err := secretsmanager.GetCertificateCurrentVersionID(context, client, secretID)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error getting the current version of the certificate %s: %s", crn, err)
+		}
+		versions[crn] = version
+	}
+	return versions, nil
+}
+
 func resourceIBMIsVPNServerDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
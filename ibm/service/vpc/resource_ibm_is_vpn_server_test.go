@@ -102,6 +102,71 @@ func TestAccIBMIsVPNServerBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMIsVPNServerRotateCertificates(t *testing.T) {
+	var vpnserver string
+	if acc.ISCertificateCrn == "" {
+		fmt.Println("[ERROR] Set the environment variable IS_CERTIFICATE_CRN for testing ibm_is_vpn_server resource")
+	}
+
+	if acc.ISClientCaCrn == "" {
+		fmt.Println("[ERROR] Set the environment variable IS_CLIENT_CA_CRN for testing ibm_is_vpn_server resource")
+	}
+	nameVpc := fmt.Sprintf("test-vpc-tf-%d", acctest.RandIntRange(10, 100))
+	nameSubnet1 := fmt.Sprintf("test-subnet1-tf-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-name%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsVPNServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPNServerConfigRotateCertificates(nameVpc, nameSubnet1, name, acc.ISCertificateCrn, acc.ISClientCaCrn, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsVPNServerExists("ibm_is_vpn_server.is_vpn_server", vpnserver),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.is_vpn_server", "rotate_certificates", "true"),
+					resource.TestCheckResourceAttrSet("ibm_is_vpn_server.is_vpn_server", fmt.Sprintf("certificate_versions.%s", acc.ISCertificateCrn)),
+					resource.TestCheckResourceAttrSet("ibm_is_vpn_server.is_vpn_server", fmt.Sprintf("certificate_versions.%s", acc.ISClientCaCrn)),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsVPNServerConfigRotateCertificates(nameVpc, nameSubnet1, name, acc.ISCertificateCrn, acc.ISClientCaCrn, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.is_vpn_server", "rotate_certificates", "false"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.is_vpn_server", "certificate_versions.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPNServerConfigRotateCertificates(nameVpc, nameSubnet1, vpnServerName, isCertificateCrn, isClientCaCrn string, rotateCertificates bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_vpc" "testacc_vpc" {
+			name = "%s"
+		}
+
+		resource "ibm_is_subnet" "testacc_subnet-1" {
+			name = "%s"
+			vpc = ibm_is_vpc.testacc_vpc.id
+			zone = "us-south-1"
+			ipv4_cidr_block = "10.240.0.0/24"
+		}
+
+		resource "ibm_is_vpn_server" "is_vpn_server" {
+			certificate_crn = "%s"
+			client_authentication {
+				method = "certificate"
+				client_ca_crn = "%s"
+			}
+			client_ip_pool = "10.5.0.0/21"
+			subnets = [ibm_is_subnet.testacc_subnet-1.id]
+			name = "%s"
+			rotate_certificates = %t
+		}
+	`, nameVpc, nameSubnet1, isCertificateCrn, isClientCaCrn, vpnServerName, rotateCertificates)
+}
+
 func testAccCheckIBMIsVPNServerConfigBasic(nameVpc string, nameSubnet1 string, clientIPPool string, clientIdleTimeout string, enableSplitTunneling string, vpnServerName string, port string, protocol string, isCertificateCrn string, isClientCaCrn string) string {
 	return fmt.Sprintf(`
 		resource "ibm_is_vpc" "testacc_vpc" {
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Types of cloud data ValidateCloudData parameters are checked against.
+const (
+	CloudDataInstanceProfile  = conns.CloudDataInstanceProfile
+	CloudDataKubernetesFlavor = conns.CloudDataKubernetesFlavor
+	CloudDataDatabaseVersion  = conns.CloudDataDatabaseVersion
+	CloudDataServicePlan      = conns.CloudDataServicePlan
+)
+
+// Prefix of the CloudDataRange entry naming the argument the lookup of the
+// CloudDataType is scoped by, like "scope:service" for the plans of a service.
+const cloudDataScopePrefix = "scope:"
+
+var cloudDataDescriptions = map[string]string{
+	CloudDataInstanceProfile:  "instance profile",
+	CloudDataKubernetesFlavor: "worker node flavor",
+	CloudDataDatabaseVersion:  "database version",
+	CloudDataServicePlan:      "service plan",
+}
+
+// cloudDataSession is the provider configuration the ValidateCloudData
+// parameters are checked against, conns.ClientSession looks the values up once
+// per provider alias.
+type cloudDataSession interface {
+	CloudDataValues(dataType, scope string) (values []string, ok bool, err error)
+}
+
+// checkCloudData checks the value of a ValidateCloudData parameter against the
+// catalog, scoped by another argument when its CloudDataRange names one. It runs
+// in CustomizeDiff rather than as a ValidateFunc so that only the values being
+// planned are checked, and resources on a retired profile, flavor, version or
+// plan can still be planned.
+func (vs ValidateSchema) checkCloudData(diff *schema.ResourceDiff, meta interface{}) error {
+	if vs.ValidateFunctionIdentifier != ValidateCloudData {
+		return nil
+	}
+	if !isConfigured(diff, vs.Identifier) || !diff.NewValueKnown(vs.Identifier) {
+		return nil
+	}
+	argument := vs.cloudDataScopeArgument()
+	if argument != "" && !diff.NewValueKnown(argument) {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange(vs.Identifier) && (argument == "" || !diff.HasChange(argument)) {
+		return nil
+	}
+	var scope string
+	if argument != "" {
+		scope = fmt.Sprint(diff.Get(argument))
+	}
+	return checkCloudData(meta, vs.CloudDataType, scope, vs.Identifier, fmt.Sprint(diff.Get(vs.Identifier)))
+}
+
+func (vs ValidateSchema) cloudDataScopeArgument() string {
+	for _, item := range vs.CloudDataRange {
+		if strings.HasPrefix(item, cloudDataScopePrefix) {
+			return strings.TrimPrefix(item, cloudDataScopePrefix)
+		}
+	}
+	return ""
+}
+
+// checkCloudData returns an error listing the valid values when the value is
+// not one of them. The check is left to the API when the provider can't tell,
+// and values are not checked before the provider is configured.
+func checkCloudData(meta interface{}, dataType, scope, key, value string) error {
+	session, ok := meta.(cloudDataSession)
+	if !ok || value == "" {
+		return nil
+	}
+	values, ok, err := session.CloudDataValues(dataType, scope)
+	if !ok {
+		return nil
+	}
+	if err != nil {
+		log.Printf("[WARN] Could not look up the valid values of %s, it is left to the API to validate: %s", key, err)
+		return nil
+	}
+	if len(values) == 0 || stringInSlice(value, values) {
+		return nil
+	}
+
+	valid := append([]string(nil), values...)
+	sort.Strings(valid)
+	description := cloudDataDescriptions[dataType]
+	if scope != "" {
+		return fmt.Errorf("%s: %q is not a valid %s of %q, expected one of: %s", key, value, description, scope, strings.Join(valid, ", "))
+	}
+	return fmt.Errorf("%s: %q is not a valid %s, expected one of: %s", key, value, description, strings.Join(valid, ", "))
+}
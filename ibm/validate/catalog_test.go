@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestInvokeConstraintValidatorCloudData(t *testing.T) {
+	SetValidatorDict(ValidatorDict{ResourceValidatorDictionary: map[string]*ResourceValidator{
+		"ibm_test_instance": {
+			ResourceName: "ibm_test_instance",
+			Schema: []ValidateSchema{{
+				Identifier:                 "profile",
+				ValidateFunctionIdentifier: ValidateCloudData,
+				Type:                       TypeString,
+				CloudDataType:              CloudDataInstanceProfile,
+			}},
+		},
+	}})
+	defer SetValidatorDict(ValidatorDict{})
+	meta := testCloudData{
+		CloudDataInstanceProfile: {"bx2-2x8", "cx2-2x4"},
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"profile": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		CustomizeDiff: InvokeConstraintValidator("ibm_test_instance"),
+	}
+	plan := func(state map[string]string, profile string) error {
+		raw := cty.ObjectVal(map[string]cty.Value{
+			"id":      cty.NullVal(cty.String),
+			"name":    cty.StringVal("my-instance"),
+			"profile": cty.StringVal(profile),
+		})
+		s := &terraform.InstanceState{RawConfig: raw, Attributes: state}
+		if state != nil {
+			s.ID = state["id"]
+		}
+		_, err := r.Diff(context.Background(), s, terraform.NewResourceConfigShimmed(raw, r.CoreConfigSchema()), meta)
+		return err
+	}
+	retired := map[string]string{"id": "0717-1", "name": "my-old-instance", "profile": "bx1-2x8"}
+
+	if err := plan(nil, "bx1-2x8"); err == nil || !strings.Contains(err.Error(), `"bx1-2x8" is not a valid instance profile, expected one of: bx2-2x8, cx2-2x4`) {
+		t.Fatalf("expected a new instance on a retired profile to be refused, got %v", err)
+	}
+	if err := plan(nil, "bx2-2x8"); err != nil {
+		t.Fatalf("expected a valid profile to be planned, got %s", err)
+	}
+	if err := plan(retired, "bx1-2x8"); err != nil {
+		t.Fatalf("expected an instance on a retired profile to be planned while the profile is unchanged, got %s", err)
+	}
+	if err := plan(retired, "bx1-4x16"); err == nil {
+		t.Fatal("expected a change to another retired profile to be refused")
+	}
+}
+
+// testCloudData is a provider configuration with the values of the types of cloud data
+type testCloudData map[string][]string
+
+func (c testCloudData) CloudDataValues(dataType, scope string) ([]string, bool, error) {
+	values, ok := c[dataType]
+	return values, ok, nil
+}
@@ -0,0 +1,430 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// enum to list the relations between parameters supported by ValidateConstraint.
+type ConstraintIdentifier int
+
+const (
+	// At most one of the parameters can be set
+	MutuallyExclusive ConstraintIdentifier = iota
+	// Exactly one of the parameters must be set
+	ExactlyOneOf
+	// Either all or none of the parameters are set
+	RequiredTogether
+	// The parameters are required when the condition holds
+	RequiredIf
+	// The parameters must have one of AllowedValues when the condition holds
+	AllowedValuesIf
+	// The number of items of the first parameter compares with Relation to the
+	// number of items, or the value, of the second parameter
+	ListLengthRelation
+	// The parameters must not be set when the condition holds
+	ForbiddenIf
+	// The parameters must be between MinValue and MaxValue when the condition holds
+	IntBetweenIf
+)
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (c ConstraintIdentifier) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// Use stringer tool to generate this later.
+func (i ConstraintIdentifier) String() string {
+	return [...]string{"MutuallyExclusive", "ExactlyOneOf", "RequiredTogether", "RequiredIf", "AllowedValuesIf", "ListLengthRelation", "ForbiddenIf", "IntBetweenIf"}[i]
+}
+
+// ValidateConstraint describes a constraint between parameters of a resource.
+// Presence is decided from the configuration, so that Computed parameters
+// filled by the API are not mistaken for configured ones.
+type ValidateConstraint struct {
+	// The function that checks the constraint.
+	ConstraintIdentifier ConstraintIdentifier
+
+	// These are the parameter names, nested parameters are written like boot_volume.0.size.
+	// Elements of a set, which have no index, are written with *, like
+	// security_group_rules.*.name, the constraint then applies to every element.
+	// ListLengthRelation only supports indexed paths.
+	Identifiers []string
+
+	// Condition is the parameter RequiredIf and AllowedValuesIf depend on. The
+	// condition holds when it is set to one of ConditionValues, a comma separated
+	// list, or whenever it is set if ConditionValues is empty.
+	Condition       string
+	ConditionValues string
+
+	AllowedValues string //Comma separated list of strings.
+
+	// Bounds for IntBetweenIf, either can be left empty
+	MinValue string
+	MaxValue string
+
+	// One of ==, !=, <, <=, > or >= for ListLengthRelation
+	Relation string
+}
+
+// InvokeConstraintValidator returns the CustomizeDiffFunc enforcing the
+// constraints registered for the resource in the validator dictionary, and
+// checking the values of its ValidateCloudData parameters against the catalog.
+func InvokeConstraintValidator(resourceName string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		resourceItem, ok := validatorDict.ResourceValidatorDictionary[resourceName]
+		if !ok || resourceItem == nil {
+			return nil
+		}
+		// Terraform sends the configuration with every plan, there is nothing
+		// to check it against otherwise
+		if diff.GetRawConfig().IsNull() {
+			return nil
+		}
+		for _, constraint := range resourceItem.Constraints {
+			if err := constraint.check(diff); err != nil {
+				return err
+			}
+		}
+		for _, validateSchema := range resourceItem.Schema {
+			if err := validateSchema.checkCloudData(diff, meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// CheckConstraintPaths verifies that the parameters of the constraints exist in
+// the resource schema, and that the elements of sets are written with *.
+func (r *ResourceValidator) CheckConstraintPaths(resourceSchema map[string]*schema.Schema) error {
+	for _, c := range r.Constraints {
+		identifiers := c.Identifiers
+		if c.Condition != "" {
+			identifiers = append([]string{c.Condition}, identifiers...)
+		}
+		for _, identifier := range identifiers {
+			if err := checkSchemaPath(resourceSchema, strings.Split(identifier, ".")); err != nil {
+				return fmt.Errorf("[ERROR] %s constraint of %s on %q: %s", c.ConstraintIdentifier, r.ResourceName, identifier, err)
+			}
+			if c.ConstraintIdentifier == ListLengthRelation && hasWildcard(identifier) {
+				return fmt.Errorf("[ERROR] %s constraint of %s on %q: set elements are not supported", c.ConstraintIdentifier, r.ResourceName, identifier)
+			}
+		}
+	}
+	return nil
+}
+
+func checkSchemaPath(resourceSchema map[string]*schema.Schema, path []string) error {
+	s, ok := resourceSchema[path[0]]
+	if !ok {
+		return fmt.Errorf("unknown parameter %q", path[0])
+	}
+	if len(path) == 1 {
+		return nil
+	}
+	step := path[1]
+	switch s.Type {
+	case schema.TypeList:
+		if _, err := strconv.Atoi(step); err != nil && step != "*" {
+			return fmt.Errorf("%q is a list, expected an index or * after it, got %q", path[0], step)
+		}
+	case schema.TypeSet:
+		if step != "*" {
+			return fmt.Errorf("%q is a set, its elements have no index and are written with *, got %q", path[0], step)
+		}
+	default:
+		return fmt.Errorf("%q has no nested parameters", path[0])
+	}
+	if len(path) == 2 {
+		return nil
+	}
+	elem, ok := s.Elem.(*schema.Resource)
+	if !ok {
+		return fmt.Errorf("the elements of %q have no nested parameters", path[0])
+	}
+	return checkSchemaPath(elem.Schema, path[2:])
+}
+
+func (c ValidateConstraint) check(diff *schema.ResourceDiff) error {
+	switch c.ConstraintIdentifier {
+	case MutuallyExclusive:
+		if set := configuredIdentifiers(diff, c.Identifiers); len(set) > 1 {
+			return fmt.Errorf("%s are mutually exclusive, only one of them can be set", quoteIdentifiers(set))
+		}
+	case ExactlyOneOf:
+		if set := configuredIdentifiers(diff, c.Identifiers); len(set) != 1 {
+			return fmt.Errorf("exactly one of %s must be set", quoteIdentifiers(c.Identifiers))
+		}
+	case RequiredTogether:
+		if set := configuredIdentifiers(diff, c.Identifiers); len(set) != 0 && len(set) != len(c.Identifiers) {
+			return fmt.Errorf("%s must be set together", quoteIdentifiers(c.Identifiers))
+		}
+	case RequiredIf:
+		if holds, condition := c.conditionHolds(diff); holds {
+			for _, identifier := range c.Identifiers {
+				if !isConfigured(diff, identifier) {
+					return fmt.Errorf("%q is required when %s", identifier, condition)
+				}
+			}
+		}
+	case ForbiddenIf:
+		if holds, condition := c.conditionHolds(diff); holds {
+			if set := configuredIdentifiers(diff, c.Identifiers); len(set) > 0 {
+				return fmt.Errorf("%q can not be set when %s", set[0], condition)
+			}
+		}
+	case AllowedValuesIf:
+		if holds, condition := c.conditionHolds(diff); holds {
+			allowedValues := splitValues(c.AllowedValues)
+			for _, identifier := range c.Identifiers {
+				values, known := newValues(diff, identifier)
+				if !known {
+					continue
+				}
+				for _, value := range values {
+					if !stringInSlice(value, allowedValues) {
+						return fmt.Errorf("%q must be one of %q when %s, got %q", identifier, allowedValues, condition, value)
+					}
+				}
+			}
+		}
+	case IntBetweenIf:
+		if holds, condition := c.conditionHolds(diff); holds {
+			return c.checkIntBetween(diff, condition)
+		}
+	case ListLengthRelation:
+		return c.checkListLength(diff)
+	}
+	return nil
+}
+
+// conditionHolds reports whether the condition of the constraint holds, with
+// its description for error messages. A condition through a set holds when it
+// holds for any of its elements.
+func (c ValidateConstraint) conditionHolds(diff *schema.ResourceDiff) (bool, string) {
+	if !isConfigured(diff, c.Condition) {
+		return false, ""
+	}
+	values, known := newValues(diff, c.Condition)
+	if !known {
+		return false, ""
+	}
+	conditionValues := splitValues(c.ConditionValues)
+	if len(conditionValues) == 0 {
+		return true, fmt.Sprintf("%q is set", c.Condition)
+	}
+	for _, value := range values {
+		if stringInSlice(value, conditionValues) {
+			return true, fmt.Sprintf("%q is %q", c.Condition, value)
+		}
+	}
+	return false, ""
+}
+
+func (c ValidateConstraint) checkIntBetween(diff *schema.ResourceDiff, condition string) error {
+	for _, identifier := range c.Identifiers {
+		if !isConfigured(diff, identifier) {
+			continue
+		}
+		values, known := newValues(diff, identifier)
+		if !known {
+			continue
+		}
+		for _, value := range values {
+			number, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("[ERROR] %q is not a number: %s", identifier, err)
+			}
+			if min, err := strconv.Atoi(c.MinValue); err == nil && number < min {
+				return fmt.Errorf("%q must be at least %d when %s, got %d", identifier, min, condition, number)
+			}
+			if max, err := strconv.Atoi(c.MaxValue); err == nil && number > max {
+				return fmt.Errorf("%q must be at most %d when %s, got %d", identifier, max, condition, number)
+			}
+		}
+	}
+	return nil
+}
+
+func (c ValidateConstraint) checkListLength(diff *schema.ResourceDiff) error {
+	if len(c.Identifiers) != 2 {
+		return fmt.Errorf("[ERROR] %s expects two parameters, got %q", c.ConstraintIdentifier, c.Identifiers)
+	}
+	list, other := c.Identifiers[0], c.Identifiers[1]
+	if hasWildcard(list) || hasWildcard(other) {
+		return fmt.Errorf("[ERROR] %s does not support set elements, got %q", c.ConstraintIdentifier, c.Identifiers)
+	}
+	if !diff.NewValueKnown(list) || !diff.NewValueKnown(other) || !isConfigured(diff, other) {
+		return nil
+	}
+	length, ok := itemCount(diff.Get(list))
+	if !ok {
+		return fmt.Errorf("[ERROR] %q is not a list", list)
+	}
+	var expected int
+	if count, ok := itemCount(diff.Get(other)); ok {
+		expected = count
+	} else if value, ok := diff.Get(other).(int); ok {
+		expected = value
+	} else {
+		return fmt.Errorf("[ERROR] %q is neither a list nor a number", other)
+	}
+	var holds bool
+	switch c.Relation {
+	case "==":
+		holds = length == expected
+	case "!=":
+		holds = length != expected
+	case "<":
+		holds = length < expected
+	case "<=":
+		holds = length <= expected
+	case ">":
+		holds = length > expected
+	case ">=":
+		holds = length >= expected
+	default:
+		return fmt.Errorf("[ERROR] Unknown relation %q", c.Relation)
+	}
+	if !holds {
+		return fmt.Errorf("the number of items of %q (%d) must be %s %d, the value of %q", list, length, c.Relation, expected, other)
+	}
+	return nil
+}
+
+// isConfigured reports whether the parameter is set in the configuration, for
+// any element of a set. A value unknown until apply counts as set.
+func isConfigured(diff *schema.ResourceDiff, identifier string) bool {
+	values := configValues(diff.GetRawConfig(), strings.Split(identifier, "."))
+	for _, value := range values {
+		if !value.IsKnown() {
+			return true
+		}
+		if value.IsNull() {
+			continue
+		}
+		if ty := value.Type(); ty.IsListType() || ty.IsSetType() || ty.IsTupleType() {
+			if value.LengthInt() > 0 {
+				return true
+			}
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// configValues walks the configuration along the path, a * step goes through
+// every element of a list or a set. The walk stops at unknown values, which
+// are returned as they are.
+func configValues(value cty.Value, path []string) []cty.Value {
+	if len(path) == 0 || value.IsNull() || !value.IsKnown() {
+		return []cty.Value{value}
+	}
+	step, ty := path[0], value.Type()
+	switch {
+	case step == "*" && (ty.IsListType() || ty.IsSetType() || ty.IsTupleType()):
+		var values []cty.Value
+		for it := value.ElementIterator(); it.Next(); {
+			_, element := it.Element()
+			values = append(values, configValues(element, path[1:])...)
+		}
+		return values
+	case ty.IsListType() || ty.IsTupleType():
+		index, err := strconv.Atoi(step)
+		if err != nil || index < 0 || index >= value.LengthInt() {
+			return nil
+		}
+		return configValues(value.Index(cty.NumberIntVal(int64(index))), path[1:])
+	case ty.IsObjectType() && ty.HasAttribute(step):
+		return configValues(value.GetAttr(step), path[1:])
+	}
+	return nil
+}
+
+// newValues returns the planned values of the parameter as strings, and false
+// when they are not known yet. Paths through a set are read from the
+// configuration as set elements cannot be addressed in the plan.
+func newValues(diff *schema.ResourceDiff, identifier string) ([]string, bool) {
+	if !hasWildcard(identifier) {
+		if !diff.NewValueKnown(identifier) {
+			return nil, false
+		}
+		return []string{fmt.Sprint(diff.Get(identifier))}, true
+	}
+	var values []string
+	for _, value := range configValues(diff.GetRawConfig(), strings.Split(identifier, ".")) {
+		if !value.IsWhollyKnown() {
+			return nil, false
+		}
+		if value.IsNull() {
+			continue
+		}
+		switch value.Type() {
+		case cty.String:
+			values = append(values, value.AsString())
+		case cty.Number:
+			values = append(values, value.AsBigFloat().Text('f', -1))
+		case cty.Bool:
+			values = append(values, strconv.FormatBool(value.True()))
+		}
+	}
+	return values, true
+}
+
+func hasWildcard(identifier string) bool {
+	for _, step := range strings.Split(identifier, ".") {
+		if step == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func configuredIdentifiers(diff *schema.ResourceDiff, identifiers []string) []string {
+	var set []string
+	for _, identifier := range identifiers {
+		if isConfigured(diff, identifier) {
+			set = append(set, identifier)
+		}
+	}
+	return set
+}
+
+func itemCount(v interface{}) (int, bool) {
+	switch v := v.(type) {
+	case []interface{}:
+		return len(v), true
+	case *schema.Set:
+		return v.Len(), true
+	}
+	return 0, false
+}
+
+func splitValues(values string) []string {
+	if strings.TrimSpace(values) == "" {
+		return nil
+	}
+	arr := strings.Split(values, ",")
+	for i, ele := range arr {
+		arr[i] = strings.TrimSpace(ele)
+	}
+	return arr
+}
+
+func quoteIdentifiers(identifiers []string) string {
+	quoted := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		quoted[i] = strconv.Quote(identifier)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,284 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func constraintTestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"profile": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"capacity": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"primary_network_interface": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"security_groups": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"primary_ip": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"reserved_ip": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"name": {
+									Type:     schema.TypeString,
+									Optional: true,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"rules": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"protocol": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"port": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// planConstraint plans a new resource with the configuration, checking the
+// constraint in CustomizeDiff.
+func planConstraint(t *testing.T, c ValidateConstraint, config string) error {
+	t.Helper()
+	r := &schema.Resource{
+		Schema: constraintTestSchema(),
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return c.check(diff)
+		},
+	}
+	block := r.CoreConfigSchema()
+	raw, err := ctyjson.Unmarshal([]byte(config), block.ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = r.Diff(context.Background(), &terraform.InstanceState{RawConfig: raw}, terraform.NewResourceConfigShimmed(raw, block), nil)
+	return err
+}
+
+func TestValidateConstraint(t *testing.T) {
+	reservedIP := "primary_network_interface.0.primary_ip.0.reserved_ip"
+	reservedIPName := "primary_network_interface.0.primary_ip.0.name"
+	cases := []struct {
+		name       string
+		constraint ValidateConstraint
+		config     string
+		err        string
+	}{
+		{
+			name:       "conflicting nested parameters",
+			constraint: ValidateConstraint{ConstraintIdentifier: MutuallyExclusive, Identifiers: []string{reservedIP, reservedIPName}},
+			config:     `{"primary_network_interface": [{"primary_ip": [{"reserved_ip": "0717-1", "name": "my-ip"}]}]}`,
+			err:        "are mutually exclusive",
+		},
+		{
+			name:       "one of the conflicting nested parameters",
+			constraint: ValidateConstraint{ConstraintIdentifier: MutuallyExclusive, Identifiers: []string{reservedIP, reservedIPName}},
+			config:     `{"primary_network_interface": [{"primary_ip": [{"reserved_ip": "0717-1"}]}]}`,
+		},
+		{
+			name:       "conflicting parameters in an empty list",
+			constraint: ValidateConstraint{ConstraintIdentifier: MutuallyExclusive, Identifiers: []string{reservedIP, reservedIPName}},
+			config:     `{"primary_network_interface": [{"primary_ip": []}]}`,
+		},
+		{
+			name:       "conflicting set element",
+			constraint: ValidateConstraint{ConstraintIdentifier: MutuallyExclusive, Identifiers: []string{"rules.*.port", "name"}},
+			config:     `{"name": "my-acl", "rules": [{"protocol": "icmp"}, {"protocol": "tcp", "port": 22}]}`,
+			err:        `"rules.*.port", "name" are mutually exclusive`,
+		},
+		{
+			name:       "set elements without the conflicting parameter",
+			constraint: ValidateConstraint{ConstraintIdentifier: MutuallyExclusive, Identifiers: []string{"rules.*.port", "name"}},
+			config:     `{"name": "my-acl", "rules": [{"protocol": "icmp"}]}`,
+		},
+		{
+			name:       "nested parameters required together",
+			constraint: ValidateConstraint{ConstraintIdentifier: RequiredTogether, Identifiers: []string{reservedIPName, "primary_network_interface.0.security_groups"}},
+			config:     `{"primary_network_interface": [{"primary_ip": [{"name": "my-ip"}]}]}`,
+			err:        "must be set together",
+		},
+		{
+			name:       "empty set is not set",
+			constraint: ValidateConstraint{ConstraintIdentifier: RequiredTogether, Identifiers: []string{reservedIPName, "primary_network_interface.0.security_groups"}},
+			config:     `{"primary_network_interface": [{"primary_ip": [{"name": "my-ip"}], "security_groups": []}]}`,
+			err:        "must be set together",
+		},
+		{
+			name:       "nested parameters set together",
+			constraint: ValidateConstraint{ConstraintIdentifier: RequiredTogether, Identifiers: []string{reservedIPName, "primary_network_interface.0.security_groups"}},
+			config:     `{"primary_network_interface": [{"primary_ip": [{"name": "my-ip"}], "security_groups": ["r006-1"]}]}`,
+		},
+		{
+			name:       "nested parameters required together, none set",
+			constraint: ValidateConstraint{ConstraintIdentifier: RequiredTogether, Identifiers: []string{reservedIPName, "primary_network_interface.0.security_groups"}},
+			config:     `{}`,
+		},
+		{
+			name:       "nested value allowed",
+			constraint: ValidateConstraint{ConstraintIdentifier: AllowedValuesIf, Identifiers: []string{reservedIPName}, Condition: reservedIP, AllowedValues: "reserved-1, reserved-2"},
+			config:     `{"primary_network_interface": [{"primary_ip": [{"reserved_ip": "0717-1", "name": "reserved-2"}]}]}`,
+		},
+		{
+			name:       "nested value not allowed",
+			constraint: ValidateConstraint{ConstraintIdentifier: AllowedValuesIf, Identifiers: []string{reservedIPName}, Condition: reservedIP, AllowedValues: "reserved-1, reserved-2"},
+			config:     `{"primary_network_interface": [{"primary_ip": [{"reserved_ip": "0717-1", "name": "my-ip"}]}]}`,
+			err:        `"primary_network_interface.0.primary_ip.0.name" must be one of ["reserved-1" "reserved-2"] when "primary_network_interface.0.primary_ip.0.reserved_ip" is set, got "my-ip"`,
+		},
+		{
+			name:       "nested condition does not hold",
+			constraint: ValidateConstraint{ConstraintIdentifier: AllowedValuesIf, Identifiers: []string{reservedIPName}, Condition: reservedIP, AllowedValues: "reserved-1"},
+			config:     `{"primary_network_interface": [{"primary_ip": [{"name": "my-ip"}]}]}`,
+		},
+		{
+			name:       "set element value not allowed",
+			constraint: ValidateConstraint{ConstraintIdentifier: AllowedValuesIf, Identifiers: []string{"rules.*.protocol"}, Condition: "profile", ConditionValues: "strict", AllowedValues: "tcp, udp"},
+			config:     `{"profile": "strict", "rules": [{"protocol": "tcp"}, {"protocol": "icmp"}]}`,
+			err:        `"rules.*.protocol" must be one of ["tcp" "udp"] when "profile" is "strict", got "icmp"`,
+		},
+		{
+			name:       "set element values allowed",
+			constraint: ValidateConstraint{ConstraintIdentifier: AllowedValuesIf, Identifiers: []string{"rules.*.protocol"}, Condition: "profile", ConditionValues: "strict", AllowedValues: "tcp, udp"},
+			config:     `{"profile": "strict", "rules": [{"protocol": "tcp"}, {"protocol": "udp"}]}`,
+		},
+		{
+			name:       "set element number",
+			constraint: ValidateConstraint{ConstraintIdentifier: AllowedValuesIf, Identifiers: []string{"rules.*.port"}, Condition: "profile", AllowedValues: "443"},
+			config:     `{"profile": "strict", "rules": [{"port": 443}]}`,
+		},
+		{
+			name:       "condition on a set element",
+			constraint: ValidateConstraint{ConstraintIdentifier: RequiredIf, Identifiers: []string{"name"}, Condition: "rules.*.protocol", ConditionValues: "icmp"},
+			config:     `{"rules": [{"protocol": "tcp"}, {"protocol": "icmp"}]}`,
+			err:        `"name" is required when "rules.*.protocol" is "icmp"`,
+		},
+		{
+			name:       "forbidden parameter",
+			constraint: ValidateConstraint{ConstraintIdentifier: ForbiddenIf, Identifiers: []string{"capacity"}, Condition: "profile", ConditionValues: "general-purpose, 5iops-tier"},
+			config:     `{"profile": "5iops-tier", "capacity": 100}`,
+			err:        `"capacity" can not be set when "profile" is "5iops-tier"`,
+		},
+		{
+			name:       "forbidden parameter left to the API",
+			constraint: ValidateConstraint{ConstraintIdentifier: ForbiddenIf, Identifiers: []string{"capacity"}, Condition: "profile", ConditionValues: "general-purpose, 5iops-tier"},
+			config:     `{"profile": "5iops-tier"}`,
+		},
+		{
+			name:       "value out of range",
+			constraint: ValidateConstraint{ConstraintIdentifier: IntBetweenIf, Identifiers: []string{"capacity"}, Condition: "profile", ConditionValues: "10iops-tier", MaxValue: "4800"},
+			config:     `{"profile": "10iops-tier", "capacity": 9600}`,
+			err:        `"capacity" must be at most 4800 when "profile" is "10iops-tier", got 9600`,
+		},
+		{
+			name:       "value in range",
+			constraint: ValidateConstraint{ConstraintIdentifier: IntBetweenIf, Identifiers: []string{"capacity"}, Condition: "profile", ConditionValues: "10iops-tier", MaxValue: "4800"},
+			config:     `{"profile": "5iops-tier", "capacity": 9600}`,
+		},
+		{
+			name:       "set element out of range",
+			constraint: ValidateConstraint{ConstraintIdentifier: IntBetweenIf, Identifiers: []string{"rules.*.port"}, Condition: "profile", MinValue: "1024"},
+			config:     `{"profile": "strict", "rules": [{"port": 8080}, {"port": 22}]}`,
+			err:        `"rules.*.port" must be at least 1024 when "profile" is set, got 22`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := planConstraint(t, c.constraint, c.config)
+			if c.err == "" && err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+			if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+				t.Fatalf("expected an error containing %q, got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestCheckConstraintPaths(t *testing.T) {
+	cases := []struct {
+		name       string
+		constraint ValidateConstraint
+		err        string
+	}{
+		{
+			name:       "nested list",
+			constraint: ValidateConstraint{ConstraintIdentifier: MutuallyExclusive, Identifiers: []string{"primary_network_interface.0.primary_ip.0.reserved_ip", "primary_network_interface.*.primary_ip.0.name"}},
+		},
+		{
+			name:       "set elements",
+			constraint: ValidateConstraint{ConstraintIdentifier: RequiredIf, Identifiers: []string{"name"}, Condition: "rules.*.protocol"},
+		},
+		{
+			name:       "set element by index",
+			constraint: ValidateConstraint{ConstraintIdentifier: RequiredIf, Identifiers: []string{"name"}, Condition: "rules.0.protocol"},
+			err:        `"rules" is a set, its elements have no index`,
+		},
+		{
+			name:       "unknown parameter",
+			constraint: ValidateConstraint{ConstraintIdentifier: MutuallyExclusive, Identifiers: []string{"name", "primary_network_interface.0.subnet"}},
+			err:        `unknown parameter "subnet"`,
+		},
+		{
+			name:       "nested parameter of a string",
+			constraint: ValidateConstraint{ConstraintIdentifier: MutuallyExclusive, Identifiers: []string{"name.0", "profile"}},
+			err:        `"name" has no nested parameters`,
+		},
+		{
+			name:       "list length of set elements",
+			constraint: ValidateConstraint{ConstraintIdentifier: ListLengthRelation, Identifiers: []string{"primary_network_interface.*.security_groups", "capacity"}, Relation: "<="},
+			err:        "set elements are not supported",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			validator := &ResourceValidator{ResourceName: "ibm_test", Constraints: []ValidateConstraint{c.constraint}}
+			err := validator.CheckConstraintPaths(constraintTestSchema())
+			if c.err == "" && err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+			if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+				t.Fatalf("expected an error containing %q, got %v", c.err, err)
+			}
+		})
+	}
+}
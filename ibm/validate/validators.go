@@ -1169,6 +1169,10 @@ type ResourceValidator struct {
 
 	// Array of validator objects. Each object refers to one parameter in the resource provider.
 	Schema []ValidateSchema
+
+	// Constraints between parameters, enforced by InvokeConstraintValidator in CustomizeDiff
+	// along with the ValidateCloudData parameters.
+	Constraints []ValidateConstraint
 }
 
 type ValidatorDict struct {